@@ -0,0 +1,10 @@
+# Fetch game center achievement by the vendor identifier.
+data "appstore_achievement" "first_win" {
+  game_center_id = "a1bf3b6f-3a5e-4a6b-8f3e-6c3b2f6f7b2d"
+  vendor_id      = "com.example.first_win"
+}
+
+# Fetch game center achievement by its identifier.
+data "appstore_achievement" "shared" {
+  id = "5ade5e98-7b45-42f9-a928-b513bf9fc279"
+}
@@ -0,0 +1,9 @@
+# List all achievements of the app's game center.
+data "appstore_achievements" "all" {
+  app_id = "497799835"
+}
+
+# List only the live achievements.
+output "live_achievements" {
+  value = [for a in data.appstore_achievements.all.achievements : a.vendor_id if a.live]
+}
@@ -1,6 +1,11 @@
 # Configuration for the App Store Connect provider.
 provider "appstore" {
-  key_id      = "2X9R4HXF34"
-  issuer_id   = "57246542-96fe-1a63-e053-0824d011072a"
-  private_key = file("key.p8")
+  key_id           = "2X9R4HXF34"
+  issuer_id        = "57246542-96fe-1a63-e053-0824d011072a"
+  private_key_path = "AuthKey_2X9R4HXF34.p8"
 }
+
+# Alternatively, leave the provider block empty and set the APPSTORE_KEY_ID, APPSTORE_ISSUER_ID
+# and APPSTORE_PRIVATE_KEY or APPSTORE_PRIVATE_KEY_PATH environment variables.
+# The unprefixed KEY_ID, ISSUER_ID and PRIVATE_KEY variables are deprecated and will be removed
+# in the next major version.
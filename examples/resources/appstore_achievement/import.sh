@@ -0,0 +1,2 @@
+# Game center achievement can be imported by its identifier.
+terraform import appstore_achievement.test 5ade5e98-7b45-42f9-a928-b513bf9fc279
@@ -7,3 +7,24 @@ resource "appstore_achievement" "test" {
   repeatable         = false
   show_before_earned = false
 }
+
+# Manage game center achievement shared by the apps of a game center group.
+resource "appstore_achievement" "shared" {
+  game_center_group_id = appstore_game_center_group.test.id
+  reference_name       = "Example Shared Achievement"
+  vendor_id            = "com.example.shared"
+  points               = 10
+  repeatable           = false
+  show_before_earned   = false
+}
+
+# Archive the achievement instead of deleting it when removed from the configuration.
+resource "appstore_achievement" "seasonal" {
+  game_center_id     = "497799835"
+  reference_name     = "Example Seasonal Achievement"
+  vendor_id          = "com.example.seasonal"
+  points             = 10
+  repeatable         = false
+  show_before_earned = false
+  deletion_policy    = "archive"
+}
@@ -0,0 +1,3 @@
+# Game center achievement image can be imported by its identifier.
+# The source file cannot be read back from App Store Connect, so the image is uploaded again on the next apply.
+terraform import appstore_achievement_image.en-US 0c8b1a4e-6a4d-4d2b-8f0e-2c6f4b9e1d3a
@@ -0,0 +1,5 @@
+# Game center achievement localization can be imported by its identifier.
+terraform import appstore_achievement_localization.en-US 3e4f6f0e-2b7c-4b8e-9a49-0f4b0f0f7a4c
+
+# Alternatively, it can be imported by the achievement identifier and the locale.
+terraform import appstore_achievement_localization.en-US 5ade5e98-7b45-42f9-a928-b513bf9fc279/en-US
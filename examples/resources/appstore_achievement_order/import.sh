@@ -0,0 +1,2 @@
+# Game center achievement order can be imported by the game center identifier.
+terraform import appstore_achievement_order.example a1bf3b6f-3a5e-4a6b-8f3e-6c3b2f6f7b2d
@@ -0,0 +1,8 @@
+# Manage the order of game center achievements, the achievements that are not listed follow the listed ones.
+resource "appstore_achievement_order" "example" {
+  game_center_id = "a1bf3b6f-3a5e-4a6b-8f3e-6c3b2f6f7b2d"
+  achievement_ids = [
+    appstore_achievement.first_win.id,
+    appstore_achievement.ten_wins.id,
+  ]
+}
@@ -0,0 +1,2 @@
+# Game center achievement release can be imported by its identifier.
+terraform import appstore_achievement_release.example 8d6b6e2c-4f7a-4b0e-9f3a-2c5d7e8f9a1b
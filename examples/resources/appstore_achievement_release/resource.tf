@@ -0,0 +1,5 @@
+# Release game center achievement with the next approved version of the app.
+resource "appstore_achievement_release" "example" {
+  achievement_id = "5ade5e98-7b45-42f9-a928-b513bf9fc279"
+  game_center_id = "a1bf3b6f-3a5e-4a6b-8f3e-6c3b2f6f7b2d"
+}
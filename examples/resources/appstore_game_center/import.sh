@@ -0,0 +1,2 @@
+# Game center can be imported by its identifier.
+terraform import appstore_game_center.test 497799835
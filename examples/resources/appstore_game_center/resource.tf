@@ -0,0 +1,5 @@
+# Enable Game Center for an app, destroying the resource leaves Game Center enabled.
+resource "appstore_game_center" "test" {
+  app_id            = "1234567890"
+  challenge_enabled = true
+}
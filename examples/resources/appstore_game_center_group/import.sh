@@ -0,0 +1,2 @@
+# Game center group can be imported by its identifier.
+terraform import appstore_game_center_group.test 6a5b3e1c-7d2f-4c1a-9b8e-0f3d2c1b4a5e
@@ -0,0 +1,5 @@
+# Share achievements and leaderboards among the apps of the group.
+resource "appstore_game_center_group" "test" {
+  reference_name  = "Example Group"
+  game_center_ids = ["497799835", "497799836"]
+}
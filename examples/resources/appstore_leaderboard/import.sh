@@ -0,0 +1,2 @@
+# Game center leaderboard can be imported by its identifier.
+terraform import appstore_leaderboard.test f1c3bd4e-1e5c-4c4b-9bb8-7c3d1b8b4a4e
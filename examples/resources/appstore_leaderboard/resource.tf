@@ -0,0 +1,21 @@
+# Manage game center leaderboard.
+resource "appstore_leaderboard" "test" {
+  game_center_id  = "497799835"
+  reference_name  = "Example Leaderboard"
+  vendor_id       = "com.example.leaderboard"
+  score_format    = "INTEGER"
+  sort_order      = "DESC"
+  submission_type = "BEST_SCORE"
+}
+
+# Manage recurring game center leaderboard.
+resource "appstore_leaderboard" "weekly" {
+  game_center_id        = "497799835"
+  reference_name        = "Weekly Leaderboard"
+  vendor_id             = "com.example.leaderboard.weekly"
+  score_format          = "ELAPSED_TIME_SECOND"
+  sort_order            = "ASC"
+  submission_type       = "BEST_SCORE"
+  recurrence_start_date = "2024-01-01T00:00:00Z"
+  recurrence_duration   = "P7D"
+}
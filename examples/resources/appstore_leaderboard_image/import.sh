@@ -0,0 +1,3 @@
+# Game center leaderboard image can be imported by its identifier.
+# The source file cannot be read back from App Store Connect, so the image is uploaded again on the next apply.
+terraform import appstore_leaderboard_image.en-US 2b6f8d1c-9e3a-4f7b-a5c4-8d1e6f2a9b3c
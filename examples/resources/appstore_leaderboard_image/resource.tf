@@ -0,0 +1,5 @@
+# Manage game center leaderboard localization image.
+resource "appstore_leaderboard_image" "en-US" {
+  leaderboard_localization_id = "<identifier of the leaderboard localization>"
+  file                        = "img.png"
+}
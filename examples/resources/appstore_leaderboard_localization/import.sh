@@ -0,0 +1,5 @@
+# Game center leaderboard localization can be imported by its identifier.
+terraform import appstore_leaderboard_localization.en-US 7d2e9f3a-4c1b-4e8a-b6d5-3a9c8e7f1b2d
+
+# Alternatively, it can be imported by the leaderboard identifier and the locale.
+terraform import appstore_leaderboard_localization.en-US f1c3bd4e-1e5c-4c4b-9bb8-7c3d1b8b4a4e/en-US
@@ -0,0 +1,8 @@
+# Manage game center leaderboard localization.
+resource "appstore_leaderboard_localization" "en-US" {
+  leaderboard_id            = "f1c3bd4e-1e5c-4c4b-9bb8-7c3d1b8b4a4e"
+  locale                    = "en-US"
+  name                      = "Test Leaderboard"
+  formatter_suffix          = " points"
+  formatter_suffix_singular = " point"
+}
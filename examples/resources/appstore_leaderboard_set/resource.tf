@@ -0,0 +1,6 @@
+# Manage game center leaderboard set.
+resource "appstore_leaderboard_set" "test" {
+  game_center_id = "497799835"
+  reference_name = "Example Leaderboard Set"
+  vendor_id      = "com.example.leaderboards"
+}
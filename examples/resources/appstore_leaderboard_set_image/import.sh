@@ -0,0 +1,3 @@
+# Game center leaderboard set image can be imported by its identifier.
+# The source file cannot be read back from App Store Connect, so the image is uploaded again on the next apply.
+terraform import appstore_leaderboard_set_image.en-US 6e9a3c1f-8b2d-4a7e-b4f1-9c3e5d8a2b6f
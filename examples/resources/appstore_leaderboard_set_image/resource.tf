@@ -0,0 +1,5 @@
+# Manage game center leaderboard set localization image.
+resource "appstore_leaderboard_set_image" "en-US" {
+  leaderboard_set_localization_id = "<identifier of the leaderboard set localization>"
+  file                            = "img.png"
+}
@@ -0,0 +1,5 @@
+# Game center leaderboard set localization can be imported by its identifier.
+terraform import appstore_leaderboard_set_localization.en-US 4a7c2e9d-1b8f-4d3a-9e6c-5f2b8a1d7e4c
+
+# Alternatively, it can be imported by the leaderboard set identifier and the locale.
+terraform import appstore_leaderboard_set_localization.en-US 9c1e1c55-2b5f-4f5a-8d2e-2f0c5d6b7a11/en-US
@@ -0,0 +1,6 @@
+# Manage game center leaderboard set localization.
+resource "appstore_leaderboard_set_localization" "en-US" {
+  leaderboard_set_id = "9c1e1c55-2b5f-4f5a-8d2e-2f0c5d6b7a11"
+  locale             = "en-US"
+  name               = "Test Leaderboard Set"
+}
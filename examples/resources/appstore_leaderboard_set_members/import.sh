@@ -0,0 +1,2 @@
+# Game center leaderboard set members can be imported by the leaderboard set identifier.
+terraform import appstore_leaderboard_set_members.test 9c1e1c55-2b5f-4f5a-8d2e-2f0c5d6b7a11
@@ -0,0 +1,8 @@
+# Manage the ordered list of leaderboards in a game center leaderboard set.
+resource "appstore_leaderboard_set_members" "test" {
+  leaderboard_set_id = appstore_leaderboard_set.test.id
+  leaderboard_ids = [
+    appstore_leaderboard.daily.id,
+    appstore_leaderboard.weekly.id,
+  ]
+}
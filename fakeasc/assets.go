@@ -0,0 +1,103 @@
+package fakeasc
+
+import (
+	"io"
+	"net/http"
+	"strings"
+)
+
+// reserveAsset prepares the asset for upload with a single operation
+// covering the whole file.
+func (s *Server) reserveAsset(obj *Object) {
+	size := 0
+	if value, ok := obj.Attributes["fileSize"].(float64); ok {
+		size = int(value)
+	}
+
+	operations := []any{}
+	if size > 0 {
+		operations = append(operations, map[string]any{
+			"method": http.MethodPut,
+			"url":    s.URL + "/upload/" + obj.ID,
+			"offset": 0,
+			"length": size,
+			"requestHeaders": []map[string]string{
+				{"name": "Content-Type", "value": "application/octet-stream"},
+			},
+		})
+	}
+
+	obj.Attributes["uploadOperations"] = operations
+	obj.Attributes["assetDeliveryState"] = map[string]any{
+		"state":    "AWAITING_UPLOAD",
+		"errors":   []any{},
+		"warnings": []any{},
+	}
+}
+
+// commitAsset completes the asset right away if the whole file was
+// uploaded, and fails it otherwise.
+func (s *Server) commitAsset(obj *Object) {
+	size, _ := obj.Attributes["fileSize"].(float64)
+	data := s.store.uploads[obj.ID]
+
+	state := map[string]any{
+		"state":    "COMPLETE",
+		"errors":   []any{},
+		"warnings": []any{},
+	}
+	if len(data) != int(size) {
+		state["state"] = "FAILED"
+		state["errors"] = []any{map[string]string{
+			"code":        "IMAGE_INCORRECT_SIZE",
+			"description": "The uploaded file size does not match the reserved file size.",
+		}}
+	}
+
+	obj.Attributes["assetDeliveryState"] = state
+	obj.Attributes["uploadOperations"] = nil
+	obj.Attributes["imageAsset"] = map[string]any{
+		"templateUrl": s.URL + "/images/" + obj.ID + "/{w}x{h}bb.{f}",
+		"width":       512,
+		"height":      512,
+	}
+}
+
+// Upload returns the data uploaded for the asset.
+func (s *Server) Upload(id string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, ok := s.store.uploads[id]
+	return data, ok
+}
+
+func (s *Server) serveUpload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if f := s.fault(r); f != nil {
+		writeFault(w, f)
+		return
+	}
+
+	id := strings.TrimPrefix(r.URL.Path, "/upload/")
+	if _, ok := s.store.objects[id]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	s.store.uploads[id] = append(s.store.uploads[id], data...)
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,122 @@
+package fakeasc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	tokenAudience    = "appstoreconnect-v1"
+	maxTokenLifetime = 20 * time.Minute
+)
+
+// GenerateKey returns a new PEM-encoded P-256 private key in the PKCS #8
+// format, the same format as the keys downloaded from App Store Connect.
+func GenerateKey() ([]byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal key: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// authenticate verifies the bearer token the same way App Store Connect
+// does: the token must be signed with ES256 by the configured key, issued by
+// the configured issuer for the App Store Connect audience, and must not live
+// longer than 20 minutes. A token with the scope claim is accepted only for the
+// listed operations.
+func (s *Server) authenticate(r *http.Request) *apiError {
+	bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || bearer == "" {
+		return errNotAuthorized("Provide a properly configured and signed bearer token, and make sure that it has not expired.")
+	}
+
+	// Without a key any well-formed token is accepted.
+	if s.publicKey == nil {
+		return nil
+	}
+
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(bearer, claims, func(token *jwt.Token) (any, error) {
+		if kid, _ := token.Header["kid"].(string); kid != s.config.KeyID {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		return s.publicKey, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}),
+		jwt.WithAudience(tokenAudience),
+		jwt.WithIssuer(s.config.IssuerID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil || !token.Valid {
+		return errNotAuthorized(fmt.Sprintf("Provide a properly configured and signed bearer token, and make sure that it has not expired. %v", err))
+	}
+
+	iat, err := claims.GetIssuedAt()
+	if err != nil || iat == nil {
+		return errNotAuthorized("The token must include the issued at time.")
+	}
+
+	exp, _ := claims.GetExpirationTime()
+	if exp.Sub(iat.Time) > maxTokenLifetime {
+		return errNotAuthorized(fmt.Sprintf("The token lifetime must not exceed %s.", maxTokenLifetime))
+	}
+
+	if scope, ok := claims["scope"]; ok && !inScope(scope, r) {
+		return errNotAuthorized(fmt.Sprintf("The token scope does not allow %s %s.", r.Method, r.URL.Path))
+	}
+
+	return nil
+}
+
+// inScope reports whether the request is one of the operations in the scope
+// claim, for example, "GET /v1/apps". An operation with a query matches only
+// the requests with the same query parameters.
+func inScope(scope any, r *http.Request) bool {
+	operations, _ := scope.([]any)
+	for _, op := range operations {
+		op, _ := op.(string)
+
+		method, target, _ := strings.Cut(op, " ")
+		path, query, hasQuery := strings.Cut(target, "?")
+		if method != r.Method || path != r.URL.Path {
+			continue
+		}
+		if hasQuery {
+			values, err := url.ParseQuery(query)
+			if err != nil || values.Encode() != r.URL.Query().Encode() {
+				continue
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func parsePublicKey(data []byte) (*ecdsa.PublicKey, error) {
+	key, err := jwt.ParseECPrivateKeyFromPEM(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key.PublicKey, nil
+}
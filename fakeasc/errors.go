@@ -0,0 +1,116 @@
+package fakeasc
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// apiError is a JSON:API error object as returned by App Store Connect.
+type apiError struct {
+	Status int          `json:"-"`
+	Code   string       `json:"code"`
+	Title  string       `json:"title"`
+	Detail string       `json:"detail"`
+	Source *errorSource `json:"source,omitempty"`
+}
+
+type errorSource struct {
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+}
+
+func (e *apiError) document() any {
+	type object struct {
+		Status string `json:"status"`
+		*apiError
+	}
+
+	return map[string]any{
+		"errors": []object{{Status: strconv.Itoa(e.Status), apiError: e}},
+	}
+}
+
+func errNotFound(typ, id string) *apiError {
+	return &apiError{
+		Status: http.StatusNotFound,
+		Code:   "NOT_FOUND",
+		Title:  "The specified resource does not exist",
+		Detail: fmt.Sprintf("There is no resource of type '%s' with id '%s'", typ, id),
+	}
+}
+
+func errPathNotFound(path string) *apiError {
+	return &apiError{
+		Status: http.StatusNotFound,
+		Code:   "NOT_FOUND",
+		Title:  "The specified resource does not exist",
+		Detail: fmt.Sprintf("The path provided does not match a defined resource type: %s", path),
+	}
+}
+
+func errMethodNotAllowed(method, typ string) *apiError {
+	return &apiError{
+		Status: http.StatusMethodNotAllowed,
+		Code:   "METHOD_NOT_ALLOWED",
+		Title:  "The request method is not valid for the resource path.",
+		Detail: fmt.Sprintf("The request method '%s' is not allowed for the resource '%s'.", method, typ),
+	}
+}
+
+func errNotAuthorized(detail string) *apiError {
+	return &apiError{
+		Status: http.StatusUnauthorized,
+		Code:   "NOT_AUTHORIZED",
+		Title:  "Authentication credentials are missing or invalid.",
+		Detail: detail,
+	}
+}
+
+func errInvalidBody(detail string) *apiError {
+	return &apiError{
+		Status: http.StatusUnprocessableEntity,
+		Code:   "ENTITY_UNPROCESSABLE",
+		Title:  "The request entity is not valid.",
+		Detail: detail,
+	}
+}
+
+func errInvalidParameter(parameter, detail string) *apiError {
+	return &apiError{
+		Status: http.StatusBadRequest,
+		Code:   "PARAMETER_ERROR.INVALID",
+		Title:  "A parameter has an invalid value",
+		Detail: detail,
+		Source: &errorSource{Parameter: parameter},
+	}
+}
+
+func errRelationshipInvalid(name, detail string) *apiError {
+	return &apiError{
+		Status: http.StatusConflict,
+		Code:   "ENTITY_ERROR.RELATIONSHIP.INVALID",
+		Title:  "The provided entity includes a relationship with an invalid value",
+		Detail: detail,
+		Source: &errorSource{Pointer: "/data/relationships/" + name},
+	}
+}
+
+func errDuplicate(attr, detail string) *apiError {
+	return &apiError{
+		Status: http.StatusConflict,
+		Code:   "ENTITY_ERROR.ATTRIBUTE.INVALID.DUPLICATE",
+		Title:  "The provided entity includes an attribute with a value that has already been used",
+		Detail: detail,
+		Source: &errorSource{Pointer: "/data/attributes/" + attr},
+	}
+}
+
+func errLiveDelete(typ, id string) *apiError {
+	return &apiError{
+		Status: http.StatusConflict,
+		Code:   "ENTITY_ERROR",
+		Title:  "The request cannot be fulfilled because of the state of another resource.",
+		Detail: fmt.Sprintf("The resource of type '%s' with id '%s' has been live and cannot be deleted, archive it instead.", typ, id),
+	}
+}
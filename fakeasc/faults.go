@@ -0,0 +1,115 @@
+package fakeasc
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Fault is an error response returned instead of handling the matching
+// requests, for example, to simulate rate limiting or an outage.
+type Fault struct {
+	// Method of the matching requests, any method matches if empty.
+	Method string
+	// Path prefix of the matching requests, for example,
+	// /v1/gameCenterAchievements, any path matches if empty.
+	Path string
+
+	Status int
+	// Code, Title and Detail of the JSON:API error object, the code defaults
+	// to a code App Store Connect uses for the status.
+	Code   string
+	Title  string
+	Detail string
+	// Header is added to the response, for example, Retry-After.
+	Header http.Header
+
+	// Times is the number of requests that fail, the fault stays until
+	// ClearFaults is called if it is zero.
+	Times int
+}
+
+// InjectFault makes the matching requests fail. Faults are checked in the
+// order they were injected, the first matching fault is returned.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// fault returns the first fault matching the request and counts it down.
+func (s *Server) fault(r *http.Request) *Fault {
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if f.Path != "" && !strings.HasPrefix(r.URL.Path, f.Path) {
+			continue
+		}
+
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+
+		return f
+	}
+
+	return nil
+}
+
+func writeFault(w http.ResponseWriter, f *Fault) {
+	for name, values := range f.Header {
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+
+	err := &apiError{
+		Status: f.Status,
+		Code:   f.Code,
+		Title:  f.Title,
+		Detail: f.Detail,
+	}
+	if err.Code == "" {
+		err.Code = faultCode(f.Status)
+	}
+	if err.Title == "" {
+		err.Title = http.StatusText(f.Status)
+	}
+	if err.Detail == "" {
+		err.Detail = "The error was injected by the fake server, status " + strconv.Itoa(f.Status) + "."
+	}
+
+	writeError(w, err)
+}
+
+func faultCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return "PARAMETER_ERROR.INVALID"
+	case http.StatusUnauthorized:
+		return "NOT_AUTHORIZED"
+	case http.StatusForbidden:
+		return "FORBIDDEN_ERROR"
+	case http.StatusNotFound:
+		return "NOT_FOUND"
+	case http.StatusConflict:
+		return "ENTITY_ERROR"
+	case http.StatusTooManyRequests:
+		return "RATE_LIMIT_EXCEEDED"
+	default:
+		return "UNEXPECTED_ERROR"
+	}
+}
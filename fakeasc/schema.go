@@ -0,0 +1,171 @@
+package fakeasc
+
+// relation describes a relationship of a resource type. Relations with an
+// inverse are not stored, they are derived from the related resources that
+// point back with the inverse relationship.
+type relation struct {
+	Type    string
+	ToMany  bool
+	Inverse string
+	// Ordered inverse relations can be replaced to reorder the related
+	// resources, the stored identifiers only define the order.
+	Ordered bool
+}
+
+type resourceSchema struct {
+	// Parents are the to-one relationships the resource belongs to, exactly
+	// one of them is required to create the resource.
+	Parents []string
+	// Root resources are created without a parent, other resources without
+	// a parent, such as apps, cannot be created.
+	Root      bool
+	Relations map[string]relation
+	Defaults  map[string]any
+	// Unique attributes must not repeat among resources of the same parent.
+	Unique []string
+	// Single resources exist at most once per parent, for example, the game
+	// center of an app.
+	Single    bool
+	Deletable bool
+	// Releases is the to-many relationship of the releases of the resource,
+	// the resource cannot be deleted once one of them is live.
+	Releases string
+	Asset    bool
+}
+
+var schemas = map[string]resourceSchema{
+	"apps": {
+		Relations: map[string]relation{
+			"gameCenterDetail": {Type: "gameCenterDetails", Inverse: "app"},
+		},
+	},
+	"gameCenterDetails": {
+		Parents: []string{"app"},
+		Relations: map[string]relation{
+			"app":                       {Type: "apps"},
+			"gameCenterAchievements":    {Type: "gameCenterAchievements", ToMany: true, Inverse: "gameCenterDetail", Ordered: true},
+			"gameCenterLeaderboards":    {Type: "gameCenterLeaderboards", ToMany: true, Inverse: "gameCenterDetail"},
+			"gameCenterLeaderboardSets": {Type: "gameCenterLeaderboardSets", ToMany: true, Inverse: "gameCenterDetail"},
+			"gameCenterGroup":           {Type: "gameCenterGroups", Inverse: "gameCenterDetails"},
+			"achievementReleases":       {Type: "gameCenterAchievementReleases", ToMany: true, Inverse: "gameCenterDetail"},
+		},
+		Defaults: map[string]any{
+			"arcadeEnabled":    false,
+			"challengeEnabled": false,
+		},
+		Single: true,
+	},
+	"gameCenterGroups": {
+		Root: true,
+		Relations: map[string]relation{
+			"gameCenterDetails":         {Type: "gameCenterDetails", ToMany: true},
+			"gameCenterAchievements":    {Type: "gameCenterAchievements", ToMany: true, Inverse: "gameCenterGroup"},
+			"gameCenterLeaderboards":    {Type: "gameCenterLeaderboards", ToMany: true, Inverse: "gameCenterGroup"},
+			"gameCenterLeaderboardSets": {Type: "gameCenterLeaderboardSets", ToMany: true, Inverse: "gameCenterGroup"},
+		},
+		Deletable: true,
+	},
+	"gameCenterAchievements": {
+		Parents: []string{"gameCenterDetail", "gameCenterGroup"},
+		Relations: map[string]relation{
+			"gameCenterDetail": {Type: "gameCenterDetails"},
+			"gameCenterGroup":  {Type: "gameCenterGroups"},
+			"localizations":    {Type: "gameCenterAchievementLocalizations", ToMany: true, Inverse: "gameCenterAchievement"},
+			"releases":         {Type: "gameCenterAchievementReleases", ToMany: true, Inverse: "gameCenterAchievement"},
+		},
+		Defaults: map[string]any{
+			"archived": false,
+		},
+		Unique:    []string{"vendorIdentifier"},
+		Deletable: true,
+		Releases:  "releases",
+	},
+	"gameCenterAchievementReleases": {
+		Parents: []string{"gameCenterAchievement"},
+		Relations: map[string]relation{
+			"gameCenterAchievement": {Type: "gameCenterAchievements"},
+			"gameCenterDetail":      {Type: "gameCenterDetails"},
+		},
+		// Releases go live once the app version is approved, use Update to
+		// simulate the approval.
+		Defaults: map[string]any{
+			"live": false,
+		},
+		Deletable: true,
+	},
+	"gameCenterAchievementLocalizations": {
+		Parents: []string{"gameCenterAchievement"},
+		Relations: map[string]relation{
+			"gameCenterAchievement":      {Type: "gameCenterAchievements"},
+			"gameCenterAchievementImage": {Type: "gameCenterAchievementImages", Inverse: "gameCenterAchievementLocalization"},
+		},
+		Unique:    []string{"locale"},
+		Deletable: true,
+	},
+	"gameCenterAchievementImages": {
+		Parents: []string{"gameCenterAchievementLocalization"},
+		Relations: map[string]relation{
+			"gameCenterAchievementLocalization": {Type: "gameCenterAchievementLocalizations"},
+		},
+		Deletable: true,
+		Asset:     true,
+	},
+	"gameCenterLeaderboards": {
+		Parents: []string{"gameCenterDetail", "gameCenterGroup"},
+		Relations: map[string]relation{
+			"gameCenterDetail": {Type: "gameCenterDetails"},
+			"gameCenterGroup":  {Type: "gameCenterGroups"},
+			"localizations":    {Type: "gameCenterLeaderboardLocalizations", ToMany: true, Inverse: "gameCenterLeaderboard"},
+		},
+		Defaults: map[string]any{
+			"archived": false,
+		},
+		Unique:    []string{"vendorIdentifier"},
+		Deletable: true,
+	},
+	"gameCenterLeaderboardLocalizations": {
+		Parents: []string{"gameCenterLeaderboard"},
+		Relations: map[string]relation{
+			"gameCenterLeaderboard":      {Type: "gameCenterLeaderboards"},
+			"gameCenterLeaderboardImage": {Type: "gameCenterLeaderboardImages", Inverse: "gameCenterLeaderboardLocalization"},
+		},
+		Unique:    []string{"locale"},
+		Deletable: true,
+	},
+	"gameCenterLeaderboardImages": {
+		Parents: []string{"gameCenterLeaderboardLocalization"},
+		Relations: map[string]relation{
+			"gameCenterLeaderboardLocalization": {Type: "gameCenterLeaderboardLocalizations"},
+		},
+		Deletable: true,
+		Asset:     true,
+	},
+	"gameCenterLeaderboardSets": {
+		Parents: []string{"gameCenterDetail", "gameCenterGroup"},
+		Relations: map[string]relation{
+			"gameCenterDetail":       {Type: "gameCenterDetails"},
+			"gameCenterGroup":        {Type: "gameCenterGroups"},
+			"gameCenterLeaderboards": {Type: "gameCenterLeaderboards", ToMany: true},
+			"localizations":          {Type: "gameCenterLeaderboardSetLocalizations", ToMany: true, Inverse: "gameCenterLeaderboardSet"},
+		},
+		Unique:    []string{"vendorIdentifier"},
+		Deletable: true,
+	},
+	"gameCenterLeaderboardSetLocalizations": {
+		Parents: []string{"gameCenterLeaderboardSet"},
+		Relations: map[string]relation{
+			"gameCenterLeaderboardSet":      {Type: "gameCenterLeaderboardSets"},
+			"gameCenterLeaderboardSetImage": {Type: "gameCenterLeaderboardSetImages", Inverse: "gameCenterLeaderboardSetLocalization"},
+		},
+		Unique:    []string{"locale"},
+		Deletable: true,
+	},
+	"gameCenterLeaderboardSetImages": {
+		Parents: []string{"gameCenterLeaderboardSetLocalization"},
+		Relations: map[string]relation{
+			"gameCenterLeaderboardSetLocalization": {Type: "gameCenterLeaderboardSetLocalizations"},
+		},
+		Deletable: true,
+		Asset:     true,
+	},
+}
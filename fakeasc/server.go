@@ -0,0 +1,708 @@
+// Package fakeasc implements an in-memory App Store Connect API for hermetic
+// tests of the provider and of the modules built on it. It serves the JSON:API
+// endpoints of apps, game center details, achievements, leaderboards and
+// leaderboard sets together with their localizations and images, verifies
+// bearer tokens, paginates lists, links relationships and lets tests inject
+// errors.
+//
+// Point the provider to the server with the endpoint attribute or the
+// APPSTORE_ENDPOINT environment variable:
+//
+//	key, _ := fakeasc.GenerateKey()
+//	srv, _ := fakeasc.NewServer(fakeasc.Config{KeyID: "2X9R4HXF34", IssuerID: "57246542-96fe-1a63-e053-0824d011072a", PrivateKey: key})
+//	defer srv.Close()
+//
+//	appID, gameCenterID := srv.AddApp("My Game", "com.example.game", "GAME")
+package fakeasc
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 200
+)
+
+// Config of the fake server.
+type Config struct {
+	// KeyID and IssuerID the bearer tokens must be issued with.
+	KeyID    string
+	IssuerID string
+	// PrivateKey is the PEM-encoded key the clients sign bearer tokens with,
+	// tokens are not verified if it is empty.
+	PrivateKey []byte
+	// PageLimit is the number of resources in a page of a list when the
+	// request does not specify the limit, it defaults to 50.
+	PageLimit int
+}
+
+// Server is a fake App Store Connect API listening on a local address.
+type Server struct {
+	// URL is the endpoint of the API in the form http://127.0.0.1:port,
+	// the API version is part of the request paths.
+	URL string
+
+	config    Config
+	publicKey *ecdsa.PublicKey
+	server    *httptest.Server
+	mu        sync.Mutex
+	store     *store
+	faults    []*Fault
+}
+
+// NewServer starts the fake server, it must be closed with Close.
+func NewServer(config Config) (*Server, error) {
+	s := &Server{
+		config: config,
+		store:  newStore(),
+	}
+
+	if s.config.PageLimit <= 0 {
+		s.config.PageLimit = defaultLimit
+	}
+
+	if len(config.PrivateKey) > 0 {
+		key, err := parsePublicKey(config.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key: %w", err)
+		}
+		s.publicKey = key
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/", s.serveAPI)
+	mux.HandleFunc("/upload/", s.serveUpload)
+
+	s.server = httptest.NewServer(mux)
+	s.URL = s.server.URL
+
+	return s, nil
+}
+
+// Close shuts down the server and blocks until all requests are finished.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Add stores a resource as if it had been created in App Store Connect and
+// returns its identifier. It panics if the resource is not valid, for
+// example, if a related resource does not exist.
+func (s *Server) Add(typ string, attrs map[string]any, relations map[string]string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rels := map[string][]string{}
+	for name, id := range relations {
+		rels[name] = []string{id}
+	}
+
+	obj, err := s.store.create(typ, attrs, rels)
+	if err != nil {
+		panic(fmt.Sprintf("fakeasc: failed to add %s: %s", typ, err.Detail))
+	}
+
+	return obj.ID
+}
+
+// AddApp stores an app with the game center enabled and returns identifiers
+// of the app and its game center detail.
+func (s *Server) AddApp(name, bundleID, sku string) (appID, gameCenterID string) {
+	appID = s.Add("apps", map[string]any{
+		"name":     name,
+		"bundleId": bundleID,
+		"sku":      sku,
+	}, nil)
+
+	gameCenterID = s.Add("gameCenterDetails", nil, map[string]string{
+		"app": appID,
+	})
+
+	return appID, gameCenterID
+}
+
+// Get returns a copy of the resource.
+func (s *Server) Get(typ, id string) (Object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj := s.store.find(typ, id)
+	if obj == nil {
+		return Object{}, false
+	}
+
+	return obj.clone(), true
+}
+
+// List returns copies of the resources of the type in the order they were
+// created.
+func (s *Server) List(typ string) []Object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	objects := []Object{}
+	for _, obj := range s.store.list(typ) {
+		objects = append(objects, obj.clone())
+	}
+
+	return objects
+}
+
+// Update changes attributes of the resource behind the back of its client,
+// which is useful to simulate drift.
+func (s *Server) Update(typ, id string, attrs map[string]any) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj := s.store.find(typ, id)
+	if obj == nil {
+		return false
+	}
+
+	return s.store.update(obj, attrs) == nil
+}
+
+// SetRelationship replaces the related resources of a stored relationship
+// behind the back of its client.
+func (s *Server) SetRelationship(typ, id, name string, ids []string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj := s.store.find(typ, id)
+	if obj == nil {
+		return false
+	}
+
+	obj.Relationships[name] = slices.Clone(ids)
+	return true
+}
+
+// Remove deletes the resource and its children behind the back of its client.
+func (s *Server) Remove(typ, id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj := s.store.find(typ, id)
+	if obj == nil {
+		return false
+	}
+
+	s.store.delete(obj)
+	return true
+}
+
+func (s *Server) serveAPI(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if f := s.fault(r); f != nil {
+		writeFault(w, f)
+		return
+	}
+
+	if err := s.authenticate(r); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/"), "/"), "/")
+	if _, ok := schemas[parts[0]]; !ok {
+		writeError(w, errPathNotFound(r.URL.Path))
+		return
+	}
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		s.list(w, r, s.store.list(parts[0]))
+	case len(parts) == 1 && r.Method == http.MethodPost:
+		s.create(w, r, parts[0])
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		s.get(w, r, parts[0], parts[1])
+	case len(parts) == 2 && r.Method == http.MethodPatch:
+		s.update(w, r, parts[0], parts[1])
+	case len(parts) == 2 && r.Method == http.MethodDelete:
+		s.delete(w, r, parts[0], parts[1])
+	case len(parts) == 3 && r.Method == http.MethodGet:
+		s.getRelated(w, r, parts[0], parts[1], parts[2])
+	case len(parts) == 4 && parts[2] == "relationships" && r.Method == http.MethodGet:
+		s.getLinkages(w, r, parts[0], parts[1], parts[3])
+	case len(parts) == 4 && parts[2] == "relationships" && r.Method == http.MethodPatch:
+		s.replaceLinkages(w, r, parts[0], parts[1], parts[3])
+	case len(parts) <= 4:
+		writeError(w, errMethodNotAllowed(r.Method, parts[0]))
+	default:
+		writeError(w, errPathNotFound(r.URL.Path))
+	}
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request, typ string) {
+	body := struct {
+		Data struct {
+			Type          string                  `json:"type"`
+			Attributes    map[string]any          `json:"attributes"`
+			Relationships map[string]relationship `json:"relationships"`
+		} `json:"data"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, errInvalidBody("The request body is not a valid JSON:API document: "+err.Error()))
+		return
+	}
+
+	if body.Data.Type != typ {
+		writeError(w, errInvalidBody(fmt.Sprintf("The resource type '%s' does not match the path '%s'.", body.Data.Type, typ)))
+		return
+	}
+
+	schema := schemas[typ]
+	if len(schema.Parents) == 0 && !schema.Root {
+		writeError(w, errMethodNotAllowed(r.Method, typ))
+		return
+	}
+
+	relations := map[string][]string{}
+	for name, rel := range body.Data.Relationships {
+		ids, err := rel.ids()
+		if err != nil {
+			writeError(w, errRelationshipInvalid(name, err.Error()))
+			return
+		}
+		relations[name] = ids
+	}
+
+	obj, apiErr := s.store.create(typ, body.Data.Attributes, relations)
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+
+	if schema.Asset {
+		s.reserveAsset(obj)
+	}
+
+	s.writeResource(w, r, http.StatusCreated, obj, s.selfURL(obj))
+}
+
+func (s *Server) get(w http.ResponseWriter, r *http.Request, typ, id string) {
+	obj := s.store.find(typ, id)
+	if obj == nil {
+		writeError(w, errNotFound(typ, id))
+		return
+	}
+
+	s.writeResource(w, r, http.StatusOK, obj, s.selfURL(obj))
+}
+
+func (s *Server) update(w http.ResponseWriter, r *http.Request, typ, id string) {
+	obj := s.store.find(typ, id)
+	if obj == nil {
+		writeError(w, errNotFound(typ, id))
+		return
+	}
+
+	body := struct {
+		Data struct {
+			ID         string         `json:"id"`
+			Type       string         `json:"type"`
+			Attributes map[string]any `json:"attributes"`
+		} `json:"data"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, errInvalidBody("The request body is not a valid JSON:API document: "+err.Error()))
+		return
+	}
+
+	if body.Data.Type != typ || body.Data.ID != id {
+		writeError(w, errInvalidBody(fmt.Sprintf("The resource '%s' '%s' does not match the path.", body.Data.Type, body.Data.ID)))
+		return
+	}
+
+	attrs := body.Data.Attributes
+	if schemas[typ].Asset {
+		uploaded, _ := attrs["uploaded"].(bool)
+		delete(attrs, "uploaded")
+		if uploaded {
+			s.commitAsset(obj)
+		}
+	}
+
+	if apiErr := s.store.update(obj, attrs); apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+
+	s.writeResource(w, r, http.StatusOK, obj, s.selfURL(obj))
+}
+
+func (s *Server) delete(w http.ResponseWriter, r *http.Request, typ, id string) {
+	if !schemas[typ].Deletable {
+		writeError(w, errMethodNotAllowed(r.Method, typ))
+		return
+	}
+
+	obj := s.store.find(typ, id)
+	if obj == nil {
+		writeError(w, errNotFound(typ, id))
+		return
+	}
+
+	if name := schemas[typ].Releases; name != "" {
+		for _, release := range s.store.related(obj, name) {
+			if release.Attributes["live"] == true {
+				writeError(w, errLiveDelete(typ, id))
+				return
+			}
+		}
+	}
+
+	s.store.delete(obj)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getRelated(w http.ResponseWriter, r *http.Request, typ, id, name string) {
+	rel, ok := schemas[typ].Relations[name]
+	if !ok {
+		writeError(w, errPathNotFound(r.URL.Path))
+		return
+	}
+
+	obj := s.store.find(typ, id)
+	if obj == nil {
+		writeError(w, errNotFound(typ, id))
+		return
+	}
+
+	related := s.store.related(obj, name)
+	if rel.ToMany {
+		s.list(w, r, related)
+		return
+	}
+
+	if len(related) == 0 {
+		writeError(w, errNotFound(rel.Type, ""))
+		return
+	}
+
+	s.writeResource(w, r, http.StatusOK, related[0], s.URL+r.URL.Path)
+}
+
+func (s *Server) getLinkages(w http.ResponseWriter, r *http.Request, typ, id, name string) {
+	rel, ok := schemas[typ].Relations[name]
+	if !ok || !rel.ToMany {
+		writeError(w, errPathNotFound(r.URL.Path))
+		return
+	}
+
+	obj := s.store.find(typ, id)
+	if obj == nil {
+		writeError(w, errNotFound(typ, id))
+		return
+	}
+
+	page, links, apiErr := s.paginate(r, s.store.related(obj, name))
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+
+	data := make([]linkage, 0, len(page))
+	for _, related := range page {
+		data = append(data, linkage{Type: related.Type, ID: related.ID})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data":  data,
+		"links": links,
+	})
+}
+
+func (s *Server) replaceLinkages(w http.ResponseWriter, r *http.Request, typ, id, name string) {
+	rel, ok := schemas[typ].Relations[name]
+	if !ok || !rel.ToMany || (rel.Inverse != "" && !rel.Ordered) {
+		writeError(w, errMethodNotAllowed(r.Method, typ))
+		return
+	}
+
+	obj := s.store.find(typ, id)
+	if obj == nil {
+		writeError(w, errNotFound(typ, id))
+		return
+	}
+
+	body := struct {
+		Data []linkage `json:"data"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, errInvalidBody("The request body is not a valid JSON:API document: "+err.Error()))
+		return
+	}
+
+	ids := make([]string, 0, len(body.Data))
+	for _, l := range body.Data {
+		if l.Type != rel.Type || s.store.find(l.Type, l.ID) == nil {
+			writeError(w, errRelationshipInvalid(name, fmt.Sprintf("There is no resource of type '%s' with id '%s'.", l.Type, l.ID)))
+			return
+		}
+		ids = append(ids, l.ID)
+	}
+
+	// Ordered relations are only reordered, the related resources must stay the same.
+	if rel.Ordered {
+		related := s.store.related(obj, name)
+		current := make([]string, 0, len(related))
+		for _, o := range related {
+			current = append(current, o.ID)
+		}
+		sorted := slices.Clone(ids)
+		slices.Sort(sorted)
+		slices.Sort(current)
+		if !slices.Equal(sorted, current) {
+			writeError(w, errRelationshipInvalid(name, fmt.Sprintf("The relationship '%s' must list every related resource exactly once.", name)))
+			return
+		}
+	}
+
+	obj.Relationships[name] = ids
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeResource writes the resource together with the related resources
+// requested by the include parameter.
+func (s *Server) writeResource(w http.ResponseWriter, r *http.Request, status int, obj *Object, self string) {
+	include, apiErr := includes(r, obj.Type)
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+
+	doc, included := s.document(obj, include)
+
+	body := map[string]any{
+		"data":  doc,
+		"links": map[string]string{"self": self},
+	}
+	if len(included) > 0 {
+		body["included"] = s.documents(included)
+	}
+
+	writeJSON(w, status, body)
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, objects []*Object) {
+	page, links, apiErr := s.paginate(r, objects)
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+
+	data := make([]resourceDocument, 0, len(page))
+	included := []*Object{}
+	for _, obj := range page {
+		include, apiErr := includes(r, obj.Type)
+		if apiErr != nil {
+			writeError(w, apiErr)
+			return
+		}
+
+		doc, related := s.document(obj, include)
+		data = append(data, doc)
+		for _, rel := range related {
+			if !slices.Contains(included, rel) {
+				included = append(included, rel)
+			}
+		}
+	}
+
+	body := map[string]any{
+		"data":  data,
+		"links": links,
+		"meta": map[string]any{
+			"paging": map[string]int{"total": len(objects), "limit": len(page)},
+		},
+	}
+	if len(included) > 0 {
+		body["included"] = s.documents(included)
+	}
+
+	writeJSON(w, http.StatusOK, body)
+}
+
+// includes returns the relationships requested by the include parameter.
+func includes(r *http.Request, typ string) (map[string]bool, *apiError) {
+	include := map[string]bool{}
+
+	value := r.URL.Query().Get("include")
+	if value == "" {
+		return include, nil
+	}
+
+	for _, name := range strings.Split(value, ",") {
+		if _, ok := schemas[typ].Relations[name]; !ok {
+			return nil, errInvalidParameter("include", fmt.Sprintf("'%s' is not a valid relationship name of '%s'.", name, typ))
+		}
+		include[name] = true
+	}
+
+	return include, nil
+}
+
+// paginate returns the page selected by the limit and cursor parameters
+// together with the links to the current and the next page.
+func (s *Server) paginate(r *http.Request, objects []*Object) ([]*Object, map[string]string, *apiError) {
+	query := r.URL.Query()
+
+	limit := s.config.PageLimit
+	if value := query.Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > maxLimit {
+			return nil, nil, errInvalidParameter("limit", fmt.Sprintf("The limit must be a number between 1 and %d.", maxLimit))
+		}
+		limit = n
+	}
+
+	offset := 0
+	if value := query.Get("cursor"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return nil, nil, errInvalidParameter("cursor", "The cursor is not valid.")
+		}
+		offset = min(n, len(objects))
+	}
+
+	end := min(offset+limit, len(objects))
+	links := map[string]string{"self": s.URL + r.URL.RequestURI()}
+
+	if end < len(objects) {
+		next := url.Values{}
+		for key, values := range query {
+			next[key] = values
+		}
+		next.Set("cursor", strconv.Itoa(end))
+		next.Set("limit", strconv.Itoa(limit))
+		links["next"] = s.URL + r.URL.Path + "?" + next.Encode()
+	}
+
+	return objects[offset:end], links, nil
+}
+
+func (s *Server) selfURL(obj *Object) string {
+	return s.URL + "/v1/" + obj.Type + "/" + obj.ID
+}
+
+type resourceDocument struct {
+	Type          string                  `json:"type"`
+	ID            string                  `json:"id"`
+	Attributes    map[string]any          `json:"attributes"`
+	Relationships map[string]relationship `json:"relationships,omitempty"`
+	Links         map[string]string       `json:"links"`
+}
+
+type relationship struct {
+	Data  json.RawMessage   `json:"data,omitempty"`
+	Links map[string]string `json:"links,omitempty"`
+}
+
+type linkage struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// ids returns identifiers of the relationship data, which is either a single
+// linkage or an array of linkages.
+func (r relationship) ids() ([]string, error) {
+	var one *linkage
+	if err := json.Unmarshal(r.Data, &one); err == nil {
+		if one == nil {
+			return nil, nil
+		}
+		return []string{one.ID}, nil
+	}
+
+	var many []linkage
+	if err := json.Unmarshal(r.Data, &many); err != nil {
+		return nil, fmt.Errorf("The relationship data is neither a resource linkage nor an array of linkages.")
+	}
+
+	ids := make([]string, 0, len(many))
+	for _, l := range many {
+		ids = append(ids, l.ID)
+	}
+	return ids, nil
+}
+
+// document returns the resource document with links of every relationship.
+// Relationship data is only present for the included relationships, the
+// related resources are returned to be added to the included resources.
+func (s *Server) document(obj *Object, include map[string]bool) (resourceDocument, []*Object) {
+	doc := resourceDocument{
+		Type:          obj.Type,
+		ID:            obj.ID,
+		Attributes:    obj.Attributes,
+		Relationships: map[string]relationship{},
+		Links:         map[string]string{"self": s.selfURL(obj)},
+	}
+
+	included := []*Object{}
+	for name, rel := range schemas[obj.Type].Relations {
+		doc.Relationships[name] = relationship{
+			Links: map[string]string{
+				"self":    s.selfURL(obj) + "/relationships/" + name,
+				"related": s.selfURL(obj) + "/" + name,
+			},
+		}
+
+		if !include[name] {
+			continue
+		}
+
+		related := s.store.related(obj, name)
+		included = append(included, related...)
+
+		var data any
+		if rel.ToMany {
+			linkages := make([]linkage, 0, len(related))
+			for _, r := range related {
+				linkages = append(linkages, linkage{Type: r.Type, ID: r.ID})
+			}
+			data = linkages
+		} else if len(related) > 0 {
+			data = linkage{Type: related[0].Type, ID: related[0].ID}
+		}
+
+		raw, _ := json.Marshal(data)
+		doc.Relationships[name] = relationship{
+			Data:  raw,
+			Links: doc.Relationships[name].Links,
+		}
+	}
+
+	return doc, included
+}
+
+// documents returns documents of the resources without relationship data.
+func (s *Server) documents(objects []*Object) []resourceDocument {
+	docs := make([]resourceDocument, 0, len(objects))
+	for _, obj := range objects {
+		doc, _ := s.document(obj, nil)
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	writeJSON(w, err.Status, err.document())
+}
@@ -0,0 +1,366 @@
+package fakeasc_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexprogrammr/appstore-go"
+	"github.com/alexprogrammr/terraform-provider-appstore/fakeasc"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	keyID    = "2X9R4HXF34"
+	issuerID = "57246542-96fe-1a63-e053-0824d011072a"
+)
+
+func newServer(t *testing.T, pageLimit int) (*fakeasc.Server, []byte) {
+	t.Helper()
+
+	key, err := fakeasc.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv, err := fakeasc.NewServer(fakeasc.Config{
+		KeyID:      keyID,
+		IssuerID:   issuerID,
+		PrivateKey: key,
+		PageLimit:  pageLimit,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Close)
+
+	return srv, key
+}
+
+func token(t *testing.T, key []byte, expireAfter time.Duration) string {
+	t.Helper()
+
+	source, err := appstore.NewTokenSource(appstore.Config{
+		KeyID:       keyID,
+		IssuerID:    issuerID,
+		PrivateKey:  key,
+		ExpireAfter: expireAfter,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bearer, err := source.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return bearer
+}
+
+func get(t *testing.T, url, bearer string, out any) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+bearer)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return resp
+}
+
+func TestServerAuthentication(t *testing.T) {
+	srv, key := newServer(t, 0)
+	otherKey, err := fakeasc.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		bearer string
+		status int
+	}{
+		{name: "valid", bearer: token(t, key, time.Minute), status: http.StatusOK},
+		{name: "missing", bearer: "", status: http.StatusUnauthorized},
+		{name: "malformed", bearer: "token", status: http.StatusUnauthorized},
+		{name: "other key", bearer: token(t, otherKey, time.Minute), status: http.StatusUnauthorized},
+		{name: "expired", bearer: token(t, key, -time.Minute), status: http.StatusUnauthorized},
+		{name: "long lived", bearer: token(t, key, time.Hour), status: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := get(t, srv.URL+"/v1/apps", tt.bearer, nil)
+			if resp.StatusCode != tt.status {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.status)
+			}
+		})
+	}
+}
+
+func TestServerScope(t *testing.T) {
+	srv, key := newServer(t, 0)
+	appID, _ := srv.AddApp("App", "com.example.app", "APP")
+
+	pk, err := jwt.ParseECPrivateKeyFromPEM(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss":   issuerID,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Minute).Unix(),
+		"aud":   "appstoreconnect-v1",
+		"scope": []string{"GET /v1/apps?filter[bundleId]=com.example.app", "GET /v1/apps/" + appID},
+	})
+	token.Header["kid"] = keyID
+
+	bearer, err := token.SignedString(pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{path: "/v1/apps?filter%5BbundleId%5D=com.example.app", status: http.StatusOK},
+		{path: "/v1/apps/" + appID, status: http.StatusOK},
+		{path: "/v1/apps", status: http.StatusUnauthorized},
+		{path: "/v1/apps/" + appID + "/gameCenterDetail", status: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			resp := get(t, srv.URL+tt.path, bearer, nil)
+			if resp.StatusCode != tt.status {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.status)
+			}
+		})
+	}
+}
+
+func TestServerPagination(t *testing.T) {
+	srv, key := newServer(t, 2)
+	bearer := token(t, key, time.Minute)
+
+	for i := 0; i < 5; i++ {
+		srv.AddApp(fmt.Sprintf("App %d", i), fmt.Sprintf("com.example.app%d", i), fmt.Sprintf("APP%d", i))
+	}
+
+	names := []string{}
+	pages := 0
+	for url := srv.URL + "/v1/apps"; url != ""; pages++ {
+		page := struct {
+			Data []struct {
+				Attributes struct {
+					Name string `json:"name"`
+				} `json:"attributes"`
+			} `json:"data"`
+			Links struct {
+				Next string `json:"next"`
+			} `json:"links"`
+		}{}
+		get(t, url, bearer, &page)
+
+		for _, app := range page.Data {
+			names = append(names, app.Attributes.Name)
+		}
+		url = page.Links.Next
+	}
+
+	if pages != 3 {
+		t.Errorf("got %d pages, want 3", pages)
+	}
+	if got := strings.Join(names, ","); got != "App 0,App 1,App 2,App 3,App 4" {
+		t.Errorf("got apps %s", got)
+	}
+}
+
+func TestServerRelationships(t *testing.T) {
+	srv, key := newServer(t, 0)
+	bearer := token(t, key, time.Minute)
+
+	_, gameCenterID := srv.AddApp("App", "com.example.app", "APP")
+	achievementID := srv.Add("gameCenterAchievements", map[string]any{
+		"referenceName":    "First Win",
+		"vendorIdentifier": "com.example.app.first_win",
+	}, map[string]string{"gameCenterDetail": gameCenterID})
+
+	type document struct {
+		Data struct {
+			Relationships map[string]struct {
+				Data  json.RawMessage   `json:"data"`
+				Links map[string]string `json:"links"`
+			} `json:"relationships"`
+		} `json:"data"`
+		Included []struct {
+			Type string `json:"type"`
+			ID   string `json:"id"`
+		} `json:"included"`
+	}
+
+	url := srv.URL + "/v1/gameCenterAchievements/" + achievementID
+
+	doc := document{}
+	get(t, url, bearer, &doc)
+
+	rel := doc.Data.Relationships["gameCenterDetail"]
+	if rel.Data != nil {
+		t.Errorf("got relationship data %s without include", rel.Data)
+	}
+	if want := url + "/gameCenterDetail"; rel.Links["related"] != want {
+		t.Errorf("got related link %s, want %s", rel.Links["related"], want)
+	}
+
+	doc = document{}
+	get(t, url+"?include=gameCenterDetail", bearer, &doc)
+
+	rel = doc.Data.Relationships["gameCenterDetail"]
+	if want := fmt.Sprintf(`{"type":"gameCenterDetails","id":"%s"}`, gameCenterID); string(rel.Data) != want {
+		t.Errorf("got relationship data %s, want %s", rel.Data, want)
+	}
+	if len(doc.Included) != 1 || doc.Included[0].ID != gameCenterID {
+		t.Errorf("got included %v, want game center %s", doc.Included, gameCenterID)
+	}
+
+	resp := get(t, url+"?include=unknown", bearer, nil)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("got status %d for unknown include, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestServerOrderedRelationship(t *testing.T) {
+	srv, key := newServer(t, 0)
+	bearer := token(t, key, time.Minute)
+
+	_, gameCenterID := srv.AddApp("App", "com.example.app", "APP")
+	ids := []string{}
+	for i := 0; i < 3; i++ {
+		ids = append(ids, srv.Add("gameCenterAchievements", map[string]any{
+			"vendorIdentifier": fmt.Sprintf("com.example.app.achievement_%d", i),
+		}, map[string]string{"gameCenterDetail": gameCenterID}))
+	}
+
+	url := srv.URL + "/v1/gameCenterDetails/" + gameCenterID + "/relationships/gameCenterAchievements"
+
+	replace := func(ids ...string) int {
+		data := []map[string]string{}
+		for _, id := range ids {
+			data = append(data, map[string]string{"type": "gameCenterAchievements", "id": id})
+		}
+		body, _ := json.Marshal(map[string]any{"data": data})
+
+		req, err := http.NewRequest(http.MethodPatch, url, strings.NewReader(string(body)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+bearer)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	order := func() string {
+		doc := struct {
+			Data []struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		}{}
+		get(t, url, bearer, &doc)
+
+		got := []string{}
+		for _, l := range doc.Data {
+			got = append(got, l.ID)
+		}
+		return strings.Join(got, ",")
+	}
+
+	if status := replace(ids[2], ids[0], ids[1]); status != http.StatusNoContent {
+		t.Fatalf("got status %d, want %d", status, http.StatusNoContent)
+	}
+	if got, want := order(), strings.Join([]string{ids[2], ids[0], ids[1]}, ","); got != want {
+		t.Errorf("got order %s, want %s", got, want)
+	}
+
+	// Reordering cannot add or remove related resources.
+	if status := replace(ids[2], ids[0]); status != http.StatusConflict {
+		t.Errorf("got status %d for a missing resource, want %d", status, http.StatusConflict)
+	}
+
+	// New resources follow the reordered ones.
+	newID := srv.Add("gameCenterAchievements", map[string]any{
+		"vendorIdentifier": "com.example.app.achievement_new",
+	}, map[string]string{"gameCenterDetail": gameCenterID})
+	if got, want := order(), strings.Join([]string{ids[2], ids[0], ids[1], newID}, ","); got != want {
+		t.Errorf("got order %s, want %s", got, want)
+	}
+}
+
+func TestServerFaults(t *testing.T) {
+	srv, key := newServer(t, 0)
+	bearer := token(t, key, time.Minute)
+
+	srv.InjectFault(fakeasc.Fault{
+		Method: http.MethodGet,
+		Path:   "/v1/apps",
+		Status: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": []string{"1"}},
+		Times:  2,
+	})
+
+	for i := 0; i < 2; i++ {
+		doc := struct {
+			Errors []struct {
+				Status string `json:"status"`
+				Code   string `json:"code"`
+			} `json:"errors"`
+		}{}
+		resp := get(t, srv.URL+"/v1/apps", bearer, &doc)
+
+		if resp.StatusCode != http.StatusTooManyRequests {
+			t.Fatalf("got status %d, want %d", resp.StatusCode, http.StatusTooManyRequests)
+		}
+		if resp.Header.Get("Retry-After") != "1" {
+			t.Errorf("got Retry-After %q, want 1", resp.Header.Get("Retry-After"))
+		}
+		if len(doc.Errors) != 1 || doc.Errors[0].Code != "RATE_LIMIT_EXCEEDED" || doc.Errors[0].Status != "429" {
+			t.Errorf("got errors %+v", doc.Errors)
+		}
+	}
+
+	if resp := get(t, srv.URL+"/v1/apps", bearer, nil); resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d after the fault, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	srv.InjectFault(fakeasc.Fault{Status: http.StatusServiceUnavailable})
+	srv.ClearFaults()
+
+	if resp := get(t, srv.URL+"/v1/apps", bearer, nil); resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d after clearing faults, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
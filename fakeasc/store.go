@@ -0,0 +1,248 @@
+package fakeasc
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Object is a resource kept by the fake server. Relationships hold the
+// identifiers of the related resources, to-one relationships have at most one.
+type Object struct {
+	ID            string
+	Type          string
+	Attributes    map[string]any
+	Relationships map[string][]string
+}
+
+func (o *Object) clone() Object {
+	c := Object{
+		ID:            o.ID,
+		Type:          o.Type,
+		Attributes:    maps.Clone(o.Attributes),
+		Relationships: map[string][]string{},
+	}
+	for name, ids := range o.Relationships {
+		c.Relationships[name] = slices.Clone(ids)
+	}
+	return c
+}
+
+// parentID returns identifier of the resource the object belongs to.
+func (o *Object) parentID() string {
+	for _, parent := range schemas[o.Type].Parents {
+		if id := o.relatedID(parent); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// relatedID returns identifier of the resource referenced by the to-one relationship.
+func (o *Object) relatedID(name string) string {
+	if ids := o.Relationships[name]; len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
+
+type store struct {
+	objects map[string]*Object
+	order   []string
+	uploads map[string][]byte
+	lastID  int
+}
+
+func newStore() *store {
+	return &store{
+		objects: map[string]*Object{},
+		uploads: map[string][]byte{},
+		lastID:  1000000000,
+	}
+}
+
+func (s *store) find(typ, id string) *Object {
+	obj, ok := s.objects[id]
+	if !ok || obj.Type != typ {
+		return nil
+	}
+	return obj
+}
+
+func (s *store) list(typ string) []*Object {
+	objects := []*Object{}
+	for _, id := range s.order {
+		if obj := s.objects[id]; obj.Type == typ {
+			objects = append(objects, obj)
+		}
+	}
+	return objects
+}
+
+// related returns the resources of the relationship, either stored on the
+// object or derived from the resources pointing back to it.
+func (s *store) related(obj *Object, name string) []*Object {
+	rel := schemas[obj.Type].Relations[name]
+
+	if rel.Inverse == "" {
+		objects := []*Object{}
+		for _, id := range obj.Relationships[name] {
+			if related := s.find(rel.Type, id); related != nil {
+				objects = append(objects, related)
+			}
+		}
+		return objects
+	}
+
+	objects := []*Object{}
+	for _, related := range s.list(rel.Type) {
+		if slices.Contains(related.Relationships[rel.Inverse], obj.ID) {
+			objects = append(objects, related)
+		}
+	}
+
+	// Resources missing from the stored order follow in creation order.
+	if order := obj.Relationships[name]; rel.Ordered && len(order) > 0 {
+		position := func(o *Object) int {
+			if i := slices.Index(order, o.ID); i >= 0 {
+				return i
+			}
+			return len(order)
+		}
+		slices.SortStableFunc(objects, func(a, b *Object) int { return position(a) - position(b) })
+	}
+
+	return objects
+}
+
+func (s *store) create(typ string, attrs map[string]any, relations map[string][]string) (*Object, *apiError) {
+	schema, ok := schemas[typ]
+	if !ok {
+		return nil, errNotFound(typ, "")
+	}
+
+	for name, ids := range relations {
+		rel, ok := schema.Relations[name]
+		if !ok || rel.Inverse != "" {
+			return nil, errRelationshipInvalid(name, fmt.Sprintf("The relationship '%s' cannot be set on '%s'.", name, typ))
+		}
+		for _, id := range ids {
+			if s.find(rel.Type, id) == nil {
+				return nil, errRelationshipInvalid(name, fmt.Sprintf("There is no resource of type '%s' with id '%s'.", rel.Type, id))
+			}
+		}
+	}
+
+	parent, parentID := "", ""
+	for _, name := range schema.Parents {
+		id := firstOf(relations[name])
+		if id == "" {
+			continue
+		}
+		if parentID != "" {
+			return nil, errRelationshipInvalid(name, fmt.Sprintf("The relationships '%s' and '%s' cannot be provided together.", parent, name))
+		}
+		parent, parentID = name, id
+	}
+	if len(schema.Parents) > 0 && parentID == "" {
+		return nil, errRelationshipInvalid(schema.Parents[0], fmt.Sprintf("You must provide a value for the relationship '%s' with this request.", strings.Join(schema.Parents, "' or '")))
+	}
+
+	if schema.Single {
+		for _, other := range s.list(typ) {
+			if other.parentID() == parentID {
+				return nil, errRelationshipInvalid(parent, fmt.Sprintf("There is already a resource of type '%s' with id '%s' for the %s '%s'.", typ, other.ID, parent, parentID))
+			}
+		}
+	}
+
+	obj := &Object{
+		Type:          typ,
+		Attributes:    maps.Clone(schema.Defaults),
+		Relationships: map[string][]string{},
+	}
+	if obj.Attributes == nil {
+		obj.Attributes = map[string]any{}
+	}
+	maps.Copy(obj.Attributes, attrs)
+	for name, ids := range relations {
+		obj.Relationships[name] = slices.Clone(ids)
+	}
+
+	if err := s.checkUnique(obj, ""); err != nil {
+		return nil, err
+	}
+
+	s.lastID++
+	obj.ID = strconv.Itoa(s.lastID)
+
+	s.objects[obj.ID] = obj
+	s.order = append(s.order, obj.ID)
+
+	return obj, nil
+}
+
+func (s *store) update(obj *Object, attrs map[string]any) *apiError {
+	updated := obj.clone()
+	maps.Copy(updated.Attributes, attrs)
+
+	if err := s.checkUnique(&updated, obj.ID); err != nil {
+		return err
+	}
+
+	obj.Attributes = updated.Attributes
+	return nil
+}
+
+// checkUnique reports a conflict if another resource of the same parent has
+// the same value of a unique attribute.
+func (s *store) checkUnique(obj *Object, id string) *apiError {
+	schema := schemas[obj.Type]
+
+	for _, other := range s.list(obj.Type) {
+		if other.ID == id || other.parentID() != obj.parentID() {
+			continue
+		}
+
+		for _, attr := range schema.Unique {
+			if value, ok := obj.Attributes[attr]; ok && value == other.Attributes[attr] {
+				return errDuplicate(attr, fmt.Sprintf("The provided entity includes an attribute with a value that has already been used. '%v' is already used by %s '%s'.", value, obj.Type, other.ID))
+			}
+		}
+	}
+
+	return nil
+}
+
+// delete removes the resource together with the resources it is a parent of.
+func (s *store) delete(obj *Object) {
+	for _, id := range slices.Clone(s.order) {
+		child, ok := s.objects[id]
+		if !ok {
+			continue
+		}
+		if child.parentID() == obj.ID {
+			s.delete(child)
+		}
+	}
+
+	delete(s.objects, obj.ID)
+	delete(s.uploads, obj.ID)
+	s.order = slices.DeleteFunc(s.order, func(id string) bool { return id == obj.ID })
+
+	// Remove dangling references from to-many relationships.
+	for _, other := range s.objects {
+		for name, ids := range other.Relationships {
+			other.Relationships[name] = slices.DeleteFunc(ids, func(id string) bool { return id == obj.ID })
+		}
+	}
+}
+
+func firstOf(ids []string) string {
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
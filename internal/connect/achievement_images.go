@@ -0,0 +1,51 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alexprogrammr/appstore-go"
+)
+
+const (
+	resourceTypeAchievementImages = "gameCenterAchievementImages"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_achievement_image
+func (c *Client) CreateAchievementImage(ctx context.Context, localizationID string, name string, data []byte) (*Resource[appstore.Asset], error) {
+	relations := map[string]relation{
+		"gameCenterAchievementLocalization": relationTo(resourceTypeAchievementLocalizations, localizationID),
+	}
+
+	asset, err := c.createAsset(ctx, resourceTypeAchievementImages, relations, name, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create achievement image: %w", err)
+	}
+
+	return asset, nil
+}
+
+// GetAchievementImageByID includes the achievement localization relationship in the response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_image_information
+func (c *Client) GetAchievementImageByID(ctx context.Context, id string) (*Resource[appstore.Asset], error) {
+	url := c.baseURL + resourceTypeAchievementImages + "/" + id + "?include=gameCenterAchievementLocalization"
+
+	resp, err := doGet[appstore.Asset](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievement image: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_achievement_image
+func (c *Client) DeleteAchievementImageByID(ctx context.Context, id string) error {
+	url := c.baseURL + resourceTypeAchievementImages + "/" + id
+
+	if err := doDelete(c, ctx, url); err != nil {
+		return fmt.Errorf("failed to delete achievement image: %w", err)
+	}
+
+	return nil
+}
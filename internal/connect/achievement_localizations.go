@@ -0,0 +1,98 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	resourceTypeAchievementLocalizations = "gameCenterAchievementLocalizations"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalization/attributes
+type AchievementLocalization struct {
+	Locale                  string `json:"locale"`
+	Name                    string `json:"name"`
+	BeforeEarnedDescription string `json:"beforeEarnedDescription"`
+	AfterEarnedDescription  string `json:"afterEarnedDescription"`
+}
+
+type AchievementLocalizationUpdate struct {
+	ID                      string `json:"-"`
+	Name                    string `json:"name"`
+	BeforeEarnedDescription string `json:"beforeEarnedDescription"`
+	AfterEarnedDescription  string `json:"afterEarnedDescription"`
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_achievement_localization
+func (c *Client) CreateAchievementLocalization(ctx context.Context, achievementID string, loc *AchievementLocalization) (*Resource[AchievementLocalization], error) {
+	url := c.baseURL + resourceTypeAchievementLocalizations
+	req := createResource{
+		Type: resourceTypeAchievementLocalizations,
+		Attr: loc,
+		Relations: map[string]relation{
+			"gameCenterAchievement": relationTo(resourceTypeAchievements, achievementID),
+		},
+	}
+
+	resp, err := doCreate[AchievementLocalization](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create achievement localization: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetAchievementLocalizationByID includes the achievement relationship in the response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_localization_information
+func (c *Client) GetAchievementLocalizationByID(ctx context.Context, id string) (*Resource[AchievementLocalization], error) {
+	url := c.baseURL + resourceTypeAchievementLocalizations + "/" + id + "?include=gameCenterAchievement"
+
+	resp, err := doGet[AchievementLocalization](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievement localization: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_an_achievement
+func (c *Client) ListAchievementLocalizations(ctx context.Context, achievementID string) ([]Resource[AchievementLocalization], error) {
+	url := c.baseURL + resourceTypeAchievements + "/" + achievementID + "/localizations"
+
+	resp, err := doList[AchievementLocalization](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list achievement localizations: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/edit_an_achievement_localization
+func (c *Client) UpdateAchievementLocalization(ctx context.Context, upd AchievementLocalizationUpdate) (*Resource[AchievementLocalization], error) {
+	url := c.baseURL + resourceTypeAchievementLocalizations + "/" + upd.ID
+	req := updateResource{
+		ID:   upd.ID,
+		Type: resourceTypeAchievementLocalizations,
+		Attr: upd,
+	}
+
+	resp, err := doUpdate[AchievementLocalization](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update achievement localization: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_achievement_localization
+func (c *Client) DeleteAchievementLocalizationByID(ctx context.Context, id string) error {
+	url := c.baseURL + resourceTypeAchievementLocalizations + "/" + id
+
+	if err := doDelete(c, ctx, url); err != nil {
+		return fmt.Errorf("failed to delete achievement localization: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	resourceTypeAchievementReleases = "gameCenterAchievementReleases"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementrelease/attributes
+type AchievementRelease struct {
+	Live bool `json:"live"`
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenterachievementreleases
+func (c *Client) CreateAchievementRelease(ctx context.Context, achievementID, gameCenterID string) (*Resource[AchievementRelease], error) {
+	url := c.baseURL + resourceTypeAchievementReleases
+	req := createResource{
+		Type: resourceTypeAchievementReleases,
+		Relations: map[string]relation{
+			"gameCenterAchievement": relationTo(resourceTypeAchievements, achievementID),
+			"gameCenterDetail":      relationTo(resourceTypeGameCenters, gameCenterID),
+		},
+	}
+
+	resp, err := doCreate[AchievementRelease](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create achievement release: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetAchievementReleaseByID includes the achievement and the game center relationships in the response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterachievementreleases-_id_
+func (c *Client) GetAchievementReleaseByID(ctx context.Context, id string) (*Resource[AchievementRelease], error) {
+	url := c.baseURL + resourceTypeAchievementReleases + "/" + id + "?include=gameCenterAchievement,gameCenterDetail"
+
+	resp, err := doGet[AchievementRelease](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievement release: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListAchievementReleases returns the releases of the achievement to the game centers it is published to.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterachievements-_id_-releases
+func (c *Client) ListAchievementReleases(ctx context.Context, achievementID string) ([]Resource[AchievementRelease], error) {
+	url := c.baseURL + resourceTypeAchievements + "/" + achievementID + "/releases"
+
+	resp, err := doList[AchievementRelease](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list achievement releases: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-gamecenterachievementreleases-_id_
+func (c *Client) DeleteAchievementReleaseByID(ctx context.Context, id string) error {
+	url := c.baseURL + resourceTypeAchievementReleases + "/" + id
+
+	if err := doDelete(c, ctx, url); err != nil {
+		return fmt.Errorf("failed to delete achievement release: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	resourceTypeAchievements = "gameCenterAchievements"
+
+	// achievementDetailsInclude and achievementDetailsLimit include the localizations and the releases, up to the
+	// largest number App Store Connect includes per achievement. Achievements with more of them are completed with
+	// separate requests.
+	achievementDetailsInclude = "localizations,releases"
+	achievementDetailsLimit   = "limit[localizations]=50&limit[releases]=50"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievement/attributes
+type Achievement struct {
+	ReferenceName    string `json:"referenceName"`
+	VendorIdentifier string `json:"vendorIdentifier"`
+	Points           int    `json:"points"`
+	Repeatable       bool   `json:"repeatable"`
+	ShowBeforeEarned bool   `json:"showBeforeEarned"`
+	Archived         bool   `json:"archived,omitempty"`
+}
+
+// AchievementDetails is the achievement together with its localizations and releases.
+type AchievementDetails struct {
+	Resource[Achievement]
+	Localizations []Resource[AchievementLocalization]
+	Releases      []Resource[AchievementRelease]
+}
+
+// AchievementUpdate sends every attribute, so that boolean values can be
+// switched off remotely.
+type AchievementUpdate struct {
+	ID               string `json:"-"`
+	ReferenceName    string `json:"referenceName"`
+	Points           int    `json:"points"`
+	Repeatable       bool   `json:"repeatable"`
+	ShowBeforeEarned bool   `json:"showBeforeEarned"`
+	Archived         bool   `json:"archived"`
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_achievement
+func (c *Client) CreateAchievement(ctx context.Context, owner Owner, ach *Achievement) (*Resource[Achievement], error) {
+	url := c.baseURL + resourceTypeAchievements
+
+	// Achievements can only be archived after they have been created.
+	attr := *ach
+	attr.Archived = false
+
+	req := createResource{
+		Type:      resourceTypeAchievements,
+		Attr:      attr,
+		Relations: owner.relations(),
+	}
+
+	resp, err := doCreate[Achievement](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create achievement: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetAchievementByID includes the game center and the game center group relationships in the response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_information
+func (c *Client) GetAchievementByID(ctx context.Context, id string) (*Resource[Achievement], error) {
+	url := c.baseURL + resourceTypeAchievements + "/" + id + "?include=gameCenterDetail,gameCenterGroup"
+
+	resp, err := doGet[Achievement](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievement: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListAchievements returns the achievements of the game center or the game center group, including the archived ones.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterdetails-_id_-gamecenterachievements
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecentergroups-_id_-gamecenterachievements
+func (c *Client) ListAchievements(ctx context.Context, owner Owner) ([]Resource[Achievement], error) {
+	url := c.baseURL + owner.path() + "/gameCenterAchievements"
+
+	resp, err := doList[Achievement](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list achievements: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetAchievementDetails includes the owner relationships, the localizations and the releases in the response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_information
+func (c *Client) GetAchievementDetails(ctx context.Context, id string) (*AchievementDetails, error) {
+	url := c.baseURL + resourceTypeAchievements + "/" + id +
+		"?include=gameCenterDetail,gameCenterGroup," + achievementDetailsInclude + "&" + achievementDetailsLimit
+
+	resp, included, err := doGetIncluded[Achievement](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievement: %w", err)
+	}
+
+	details, err := c.achievementDetails(ctx, resp, included)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievement: %w", err)
+	}
+
+	return details, nil
+}
+
+// ListAchievementDetails returns the achievements of the game center or the game center group together with their
+// localizations and releases, including the archived achievements.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterdetails-_id_-gamecenterachievements
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecentergroups-_id_-gamecenterachievements
+func (c *Client) ListAchievementDetails(ctx context.Context, owner Owner) ([]AchievementDetails, error) {
+	url := c.baseURL + owner.path() + "/gameCenterAchievements?include=" + achievementDetailsInclude + "&" + achievementDetailsLimit
+
+	resp, included, err := doListIncluded[Achievement](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list achievements: %w", err)
+	}
+
+	achievements := make([]AchievementDetails, 0, len(resp))
+	for i := range resp {
+		details, err := c.achievementDetails(ctx, &resp[i], included)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list achievements: %w", err)
+		}
+
+		achievements = append(achievements, *details)
+	}
+
+	return achievements, nil
+}
+
+// achievementDetails takes the localizations and the releases from the included resources, and lists them
+// separately if the response does not include all of them.
+func (c *Client) achievementDetails(ctx context.Context, achievement *Resource[Achievement], included []includedResource) (*AchievementDetails, error) {
+	details := &AchievementDetails{Resource: *achievement}
+
+	localizations, ok, err := relatedIncluded[AchievementLocalization](achievement, "localizations", included)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		localizations, err = c.ListAchievementLocalizations(ctx, achievement.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	details.Localizations = localizations
+
+	releases, ok, err := relatedIncluded[AchievementRelease](achievement, "releases", included)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		releases, err = c.ListAchievementReleases(ctx, achievement.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	details.Releases = releases
+
+	return details, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_achievement
+func (c *Client) UpdateAchievement(ctx context.Context, upd AchievementUpdate) (*Resource[Achievement], error) {
+	url := c.baseURL + resourceTypeAchievements + "/" + upd.ID
+	req := updateResource{
+		ID:   upd.ID,
+		Type: resourceTypeAchievements,
+		Attr: upd,
+	}
+
+	resp, err := doUpdate[Achievement](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update achievement: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_achievement
+func (c *Client) DeleteAchievementByID(ctx context.Context, id string) error {
+	url := c.baseURL + resourceTypeAchievements + "/" + id
+
+	if err := doDelete(c, ctx, url); err != nil {
+		return fmt.Errorf("failed to delete achievement: %w", err)
+	}
+
+	return nil
+}
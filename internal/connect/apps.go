@@ -0,0 +1,54 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	resourceTypeApps = "apps"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/app/attributes
+type App struct {
+	Name     string `json:"name"`
+	BundleID string `json:"bundleId"`
+	SKU      string `json:"sku"`
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_information
+func (c *Client) GetApp(ctx context.Context, id string) (*Resource[App], error) {
+	url := c.baseURL + resourceTypeApps + "/" + id
+
+	resp, err := doGet[App](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/list_apps
+func (c *Client) ListApps(ctx context.Context) ([]Resource[App], error) {
+	url := c.baseURL + resourceTypeApps
+
+	resp, err := doList[App](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list apps: %w", err)
+	}
+
+	return resp, nil
+}
+
+// VerifyCredentials sends the cheapest authenticated request, reading a single app, to check that App Store Connect
+// accepts the tokens of the client.
+func (c *Client) VerifyCredentials(ctx context.Context) error {
+	url := c.baseURL + resourceTypeApps + "?limit=1"
+
+	if err := c.do(ctx, http.MethodGet, url, nil, http.StatusOK, nil); err != nil {
+		return fmt.Errorf("failed to verify credentials: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package connect
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alexprogrammr/appstore-go"
+)
+
+const (
+	assetPollInterval      = 2 * time.Second
+	assetProcessingTimeout = 5 * time.Minute
+	assetCleanupTimeout    = 30 * time.Second
+)
+
+type createAsset struct {
+	Name string `json:"fileName"`
+	Size int    `json:"fileSize"`
+}
+
+type commitAsset struct {
+	Uploaded bool `json:"uploaded"`
+}
+
+// createAsset reserves an asset, uploads its data, commits the upload and
+// waits until App Store Connect has processed the asset. The reservation is
+// deleted if any of the steps fail, so that no half-uploaded asset is left
+// behind.
+func (c *Client) createAsset(ctx context.Context, resourceType string, relations map[string]relation, name string, data []byte) (*Resource[appstore.Asset], error) {
+	url := c.baseURL + resourceType
+	req := createResource{
+		Type: resourceType,
+		Attr: createAsset{
+			Name: name,
+			Size: len(data),
+		},
+		Relations: relations,
+	}
+
+	asset, err := doCreate[appstore.Asset](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve asset: %w", err)
+	}
+
+	uploaded, err := c.uploadAsset(ctx, url+"/"+asset.ID, asset, data)
+	if err != nil {
+		// The reservation is deleted even if the upload failed because the
+		// context was canceled, for example, when Terraform is interrupted.
+		cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), assetCleanupTimeout)
+		defer cancel()
+
+		if derr := doDelete(c, cleanupCtx, url+"/"+asset.ID); derr != nil {
+			return nil, fmt.Errorf("%w (failed to delete asset reservation: %v)", err, derr)
+		}
+		return nil, err
+	}
+
+	return uploaded, nil
+}
+
+func (c *Client) uploadAsset(ctx context.Context, url string, asset *Resource[appstore.Asset], data []byte) (*Resource[appstore.Asset], error) {
+	for _, op := range asset.Attr.Operations {
+		if op.Offset < 0 || op.Offset+op.Length > len(data) {
+			return nil, fmt.Errorf("upload operation is out of range: offset %d, length %d", op.Offset, op.Length)
+		}
+
+		if err := c.uploadAssetChunk(ctx, op, data[op.Offset:op.Offset+op.Length]); err != nil {
+			return nil, fmt.Errorf("failed to upload asset chunk: %w", err)
+		}
+	}
+
+	req := updateResource{
+		ID:   asset.ID,
+		Type: asset.Type,
+		Attr: commitAsset{Uploaded: true},
+	}
+
+	if _, err := doUpdate[appstore.Asset](c, ctx, url, req); err != nil {
+		return nil, fmt.Errorf("failed to commit asset: %w", err)
+	}
+
+	return c.waitForAsset(ctx, url)
+}
+
+func (c *Client) uploadAssetChunk(ctx context.Context, op appstore.UploadOperation, chunk []byte) error {
+	req, err := http.NewRequestWithContext(ctx, op.Method, op.URL, bytes.NewReader(chunk))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for _, h := range op.Headers {
+		req.Header.Set(h.Name, h.Value)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// waitForAsset polls the asset until its delivery state is either COMPLETE or FAILED.
+func (c *Client) waitForAsset(ctx context.Context, url string) (*Resource[appstore.Asset], error) {
+	ctx, cancel := context.WithTimeout(ctx, assetProcessingTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(assetPollInterval)
+	defer ticker.Stop()
+
+	for {
+		asset, err := doGet[appstore.Asset](c, ctx, url)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get asset: %w", err)
+		}
+
+		switch asset.Attr.State.State {
+		case appstore.AssetStateComplete:
+			return asset, nil
+		case appstore.AssetStateFailed:
+			return nil, assetError(asset.Attr.State)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("asset was not processed in time, last state %s: %w", asset.Attr.State.State, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func assetError(state appstore.AssetDeliveryState) error {
+	if len(state.Errors) == 0 {
+		return fmt.Errorf("asset processing failed")
+	}
+
+	msgs := make([]string, 0, len(state.Errors))
+	for _, e := range state.Errors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", e.Code, e.Description))
+	}
+
+	return fmt.Errorf("asset processing failed: %s", strings.Join(msgs, "; "))
+}
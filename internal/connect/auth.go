@@ -0,0 +1,43 @@
+package connect
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/alexprogrammr/appstore-go"
+)
+
+type authTransport struct {
+	base   http.RoundTripper
+	source appstore.TokenSource
+}
+
+// NewAuthTransport wraps the transport so that every attempt of a request is signed with a token taken from the
+// source when the attempt starts, a retry that waited longer than the token lives is not rejected as expired.
+// Requests without the Authorization header, such as uploads to pre-signed URLs, are sent as is.
+func NewAuthTransport(base http.RoundTripper, source appstore.TokenSource) http.RoundTripper {
+	return &authTransport{
+		base:   base,
+		source: source,
+	}
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") == "" {
+		return t.base.RoundTrip(req)
+	}
+
+	token, err := t.source.Token()
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+
+	// The request of the caller must not be modified, the clone shares its body.
+	signed := req.Clone(req.Context())
+	signed.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	return t.base.RoundTrip(signed)
+}
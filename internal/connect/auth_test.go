@@ -0,0 +1,94 @@
+package connect
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/fakeasc"
+)
+
+func TestAuthTransportRetryAfterTokenExpiry(t *testing.T) {
+	key, err := fakeasc.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := TokenConfig{
+		KeyID:      "2X9R4HXF34",
+		IssuerID:   "57246542-96fe-1a63-e053-0824d011072a",
+		PrivateKey: key,
+		Lifetime:   time.Second,
+	}
+
+	srv, err := fakeasc.NewServer(fakeasc.Config{KeyID: config.KeyID, IssuerID: config.IssuerID, PrivateKey: key})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Close)
+	srv.AddApp("Test App", "com.example.test", "TESTAPP")
+
+	// The retry waits longer than the token of the first attempt lives.
+	srv.InjectFault(fakeasc.Fault{
+		Method: http.MethodGet,
+		Path:   "/v1/apps",
+		Status: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": []string{"2"}},
+		Times:  1,
+	})
+
+	source, err := NewTokenSource(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy := RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Second}
+	client := NewClient(&http.Client{Transport: NewRetryTransport(NewAuthTransport(http.DefaultTransport, source), policy)}, source)
+	client.SetEndpoint(srv.URL)
+
+	apps, err := client.ListApps(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(apps) != 1 {
+		t.Errorf("got %d apps, want 1", len(apps))
+	}
+}
+
+func TestAuthTransportUnsignedRequest(t *testing.T) {
+	source := tokenSourceFunc(func() (string, error) {
+		t.Error("token requested for a request without the Authorization header")
+		return "", nil
+	})
+
+	var authorization string
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	t.Cleanup(srv.Close)
+
+	transport := NewAuthTransport(http.DefaultTransport, source)
+
+	req, err := http.NewRequest(http.MethodPut, srv.URL+"/upload?signature=abc", http.NoBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if authorization != "" {
+		t.Errorf("got Authorization %q, want none", authorization)
+	}
+}
+
+type tokenSourceFunc func() (string, error)
+
+func (f tokenSourceFunc) Token() (string, error) {
+	return f()
+}
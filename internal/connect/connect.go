@@ -0,0 +1,178 @@
+// Package connect implements the App Store Connect API endpoints used by the
+// provider, reusing the token source and asset types of appstore-go.
+package connect
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alexprogrammr/appstore-go"
+)
+
+// DefaultEndpoint is the production App Store Connect API host.
+const DefaultEndpoint = "https://api.appstoreconnect.apple.com"
+
+// apiVersion is the path prefix of every endpoint used by the client.
+const apiVersion = "v1/"
+
+type Resource[T any] struct {
+	ID        string                  `json:"id"`
+	Type      string                  `json:"type"`
+	Attr      T                       `json:"attributes"`
+	Relations map[string]Relationship `json:"relationships"`
+	Links     Links                   `json:"links"`
+}
+
+// RelatedID returns identifier of the resource referenced by the to-one relationship,
+// or an empty string if the relationship data was not included in the response.
+func (r *Resource[T]) RelatedID(name string) string {
+	rel, ok := r.Relations[name]
+	if !ok || len(rel.Data) == 0 {
+		return ""
+	}
+
+	var data *linkage
+	if err := json.Unmarshal(rel.Data, &data); err != nil || data == nil {
+		return ""
+	}
+
+	return data.ID
+}
+
+// Relationship keeps the raw relationship data, as it is either a single
+// resource linkage, an array of linkages or null.
+type Relationship struct {
+	Data json.RawMessage   `json:"data"`
+	Meta *relationshipMeta `json:"meta"`
+}
+
+// relationshipMeta tells the number of related resources when only a part of
+// them is listed in the relationship data.
+type relationshipMeta struct {
+	Paging struct {
+		Total int `json:"total"`
+	} `json:"paging"`
+}
+
+// includedResource is a related resource of a compound document, its attributes
+// are decoded once its type is known.
+type includedResource = Resource[json.RawMessage]
+
+// relatedIncluded returns the resources referenced by the to-many relationship
+// from the included resources of the response. It reports false if the
+// relationship data was not included or lists only a part of the resources.
+func relatedIncluded[T, R any](r *Resource[R], name string, included []includedResource) ([]Resource[T], bool, error) {
+	rel, ok := r.Relations[name]
+	if !ok || len(rel.Data) == 0 {
+		return nil, false, nil
+	}
+
+	var linkages []linkage
+	if err := json.Unmarshal(rel.Data, &linkages); err != nil {
+		return nil, false, fmt.Errorf("failed to decode relationship %s: %w", name, err)
+	}
+	if rel.Meta != nil && rel.Meta.Paging.Total > len(linkages) {
+		return nil, false, nil
+	}
+
+	resources := make([]Resource[T], 0, len(linkages))
+	for _, l := range linkages {
+		i := slices.IndexFunc(included, func(inc includedResource) bool {
+			return inc.Type == l.Type && inc.ID == l.ID
+		})
+		if i < 0 {
+			return nil, false, nil
+		}
+
+		resource := Resource[T]{
+			ID:        included[i].ID,
+			Type:      included[i].Type,
+			Relations: included[i].Relations,
+			Links:     included[i].Links,
+		}
+		if err := json.Unmarshal(included[i].Attr, &resource.Attr); err != nil {
+			return nil, false, fmt.Errorf("failed to decode included %s: %w", l.Type, err)
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return resources, true, nil
+}
+
+type Links struct {
+	Self  string `json:"self"`
+	Next  string `json:"next"`
+	First string `json:"first"`
+}
+
+type Client struct {
+	httpClient  appstore.HTTPClient
+	tokenSource appstore.TokenSource
+	baseURL     string
+}
+
+func NewClient(httpClient appstore.HTTPClient, tokenSource appstore.TokenSource) *Client {
+	return &Client{
+		httpClient:  httpClient,
+		tokenSource: tokenSource,
+		baseURL:     DefaultEndpoint + "/" + apiVersion,
+	}
+}
+
+// SetEndpoint points the client to another App Store Connect API host, for example, a proxy or a local fake.
+// The endpoint may include a path prefix, the API version is appended to it.
+func (c *Client) SetEndpoint(endpoint string) {
+	c.baseURL = strings.TrimSuffix(endpoint, "/") + "/" + apiVersion
+}
+
+type response[T any] struct {
+	Data     T                  `json:"data"`
+	Included []includedResource `json:"included"`
+	Links    Links              `json:"links"`
+}
+
+type createResource struct {
+	Type      string              `json:"type"`
+	Attr      any                 `json:"attributes,omitempty"`
+	Relations map[string]relation `json:"relationships"`
+}
+
+type updateResource struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Attr any    `json:"attributes"`
+}
+
+type relation struct {
+	Data linkage `json:"data"`
+}
+
+type linkage struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+func relationTo(resourceType, id string) relation {
+	return relation{Data: linkage{ID: id, Type: resourceType}}
+}
+
+func linkagesTo(resourceType string, ids []string) []linkage {
+	linkages := make([]linkage, 0, len(ids))
+	for _, id := range ids {
+		linkages = append(linkages, linkage{ID: id, Type: resourceType})
+	}
+
+	return linkages
+}
+
+func linkageIDs(linkages []linkage) []string {
+	ids := make([]string, 0, len(linkages))
+	for _, l := range linkages {
+		ids = append(ids, l.ID)
+	}
+
+	return ids
+}
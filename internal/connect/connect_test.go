@@ -0,0 +1,57 @@
+package connect
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRelatedIncluded(t *testing.T) {
+	included := []includedResource{
+		{ID: "1", Type: "gameCenterAchievementLocalizations", Attr: json.RawMessage(`{"locale":"en-US"}`)},
+		{ID: "2", Type: "gameCenterAchievementLocalizations", Attr: json.RawMessage(`{"locale":"de-DE"}`)},
+	}
+
+	tests := []struct {
+		name     string
+		relation string
+		want     []string
+		ok       bool
+	}{
+		{name: "included", relation: `{"data":[{"type":"gameCenterAchievementLocalizations","id":"2"},{"type":"gameCenterAchievementLocalizations","id":"1"}]}`, want: []string{"de-DE", "en-US"}, ok: true},
+		{name: "empty", relation: `{"data":[]}`, want: []string{}, ok: true},
+		{name: "not requested", relation: `{"links":{"related":"https://api.appstoreconnect.apple.com/v1/gameCenterAchievements/1/localizations"}}`},
+		{name: "partial", relation: `{"data":[{"type":"gameCenterAchievementLocalizations","id":"1"}],"meta":{"paging":{"total":2,"limit":1}}}`},
+		{name: "missing", relation: `{"data":[{"type":"gameCenterAchievementLocalizations","id":"3"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rel Relationship
+			if err := json.Unmarshal([]byte(tt.relation), &rel); err != nil {
+				t.Fatal(err)
+			}
+			achievement := &Resource[Achievement]{ID: "1", Relations: map[string]Relationship{"localizations": rel}}
+
+			localizations, ok, err := relatedIncluded[AchievementLocalization](achievement, "localizations", included)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != tt.ok {
+				t.Fatalf("got ok %t, want %t", ok, tt.ok)
+			}
+
+			locales := []string{}
+			for _, loc := range localizations {
+				locales = append(locales, loc.Attr.Locale)
+			}
+			if ok && len(locales) != len(tt.want) {
+				t.Fatalf("got locales %v, want %v", locales, tt.want)
+			}
+			for i := range tt.want {
+				if locales[i] != tt.want[i] {
+					t.Errorf("got locales %v, want %v", locales, tt.want)
+				}
+			}
+		})
+	}
+}
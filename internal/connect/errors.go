@@ -0,0 +1,86 @@
+package connect
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Error is returned when App Store Connect responds with an unexpected status code.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/errorresponse
+type Error struct {
+	StatusCode int
+	Errors     []ErrorObject
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/errorresponse/errors
+type ErrorObject struct {
+	ID     string       `json:"id"`
+	Status string       `json:"status"`
+	Code   string       `json:"code"`
+	Title  string       `json:"title"`
+	Detail string       `json:"detail"`
+	Source *ErrorSource `json:"source,omitempty"`
+}
+
+// ErrorSource references the part of the request that caused the error, either
+// a JSON pointer into the request document or a query parameter.
+type ErrorSource struct {
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+}
+
+func (e *Error) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+	}
+
+	msgs := make([]string, 0, len(e.Errors))
+	for _, o := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", o.Title, o.Detail))
+	}
+
+	return fmt.Sprintf("unexpected status code: %d: %s", e.StatusCode, strings.Join(msgs, "; "))
+}
+
+// IsNotFound reports whether the error was caused by a resource that does not exist.
+func IsNotFound(err error) bool {
+	var e *Error
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	if e.StatusCode == http.StatusNotFound {
+		return true
+	}
+
+	for _, o := range e.Errors {
+		if o.Code == "NOT_FOUND" || strings.HasPrefix(o.Code, "NOT_FOUND.") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsUnauthorized reports whether the request was rejected because the token is invalid, for example, signed by
+// a revoked key or issued for another key ID or issuer.
+func IsUnauthorized(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether the request was rejected because the key is not allowed to perform it.
+func IsForbidden(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusForbidden
+}
+
+// IsConflict reports whether the request was rejected because of the state of the resource, for example, deletion of
+// an achievement or a leaderboard that has been live.
+func IsConflict(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusConflict
+}
@@ -0,0 +1,131 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	resourceTypeGameCenterGroups = "gameCenterGroups"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroup/attributes
+type GameCenterGroup struct {
+	ReferenceName string `json:"referenceName"`
+}
+
+type GameCenterGroupUpdate struct {
+	ID            string `json:"-"`
+	ReferenceName string `json:"referenceName"`
+}
+
+// Owner is the game center of a single app or the game center group shared by several apps. Achievements,
+// leaderboards and leaderboard sets belong to exactly one of them, the group takes precedence if both are set.
+type Owner struct {
+	GameCenterID string
+	GroupID      string
+}
+
+func (o Owner) relations() map[string]relation {
+	if o.GroupID != "" {
+		return map[string]relation{
+			"gameCenterGroup": relationTo(resourceTypeGameCenterGroups, o.GroupID),
+		}
+	}
+
+	return map[string]relation{
+		"gameCenterDetail": relationTo(resourceTypeGameCenters, o.GameCenterID),
+	}
+}
+
+// path returns the path of the owner relative to the base URL.
+func (o Owner) path() string {
+	if o.GroupID != "" {
+		return resourceTypeGameCenterGroups + "/" + o.GroupID
+	}
+
+	return resourceTypeGameCenters + "/" + o.GameCenterID
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecentergroups
+func (c *Client) CreateGameCenterGroup(ctx context.Context, group *GameCenterGroup) (*Resource[GameCenterGroup], error) {
+	url := c.baseURL + resourceTypeGameCenterGroups
+	req := createResource{
+		Type: resourceTypeGameCenterGroups,
+		Attr: group,
+	}
+
+	resp, err := doCreate[GameCenterGroup](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create game center group: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecentergroups-_id_
+func (c *Client) GetGameCenterGroupByID(ctx context.Context, id string) (*Resource[GameCenterGroup], error) {
+	url := c.baseURL + resourceTypeGameCenterGroups + "/" + id
+
+	resp, err := doGet[GameCenterGroup](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game center group: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-gamecentergroups-_id_
+func (c *Client) UpdateGameCenterGroup(ctx context.Context, upd GameCenterGroupUpdate) (*Resource[GameCenterGroup], error) {
+	url := c.baseURL + resourceTypeGameCenterGroups + "/" + upd.ID
+	req := updateResource{
+		ID:   upd.ID,
+		Type: resourceTypeGameCenterGroups,
+		Attr: upd,
+	}
+
+	resp, err := doUpdate[GameCenterGroup](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update game center group: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-gamecentergroups-_id_
+func (c *Client) DeleteGameCenterGroupByID(ctx context.Context, id string) error {
+	url := c.baseURL + resourceTypeGameCenterGroups + "/" + id
+
+	if err := doDelete(c, ctx, url); err != nil {
+		return fmt.Errorf("failed to delete game center group: %w", err)
+	}
+
+	return nil
+}
+
+// GetGameCenterGroupMembers returns identifiers of the game centers in the group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecentergroups-_id_-relationships-gamecenterdetails
+func (c *Client) GetGameCenterGroupMembers(ctx context.Context, id string) ([]string, error) {
+	url := c.baseURL + resourceTypeGameCenterGroups + "/" + id + "/relationships/gameCenterDetails"
+
+	linkages, err := doGetLinkages(c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game center group members: %w", err)
+	}
+
+	return linkageIDs(linkages), nil
+}
+
+// ReplaceGameCenterGroupMembers replaces the game centers in the group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-gamecentergroups-_id_-relationships-gamecenterdetails
+func (c *Client) ReplaceGameCenterGroupMembers(ctx context.Context, id string, gameCenterIDs []string) error {
+	url := c.baseURL + resourceTypeGameCenterGroups + "/" + id + "/relationships/gameCenterDetails"
+
+	if err := doReplaceLinkages(c, ctx, url, linkagesTo(resourceTypeGameCenters, gameCenterIDs)); err != nil {
+		return fmt.Errorf("failed to replace game center group members: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	resourceTypeGameCenters = "gameCenterDetails"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetail/attributes
+type GameCenter struct {
+	ArcadeEnabled    bool `json:"arcadeEnabled"`
+	ChallengeEnabled bool `json:"challengeEnabled"`
+}
+
+type GameCenterUpdate struct {
+	ID               string `json:"-"`
+	ArcadeEnabled    bool   `json:"arcadeEnabled"`
+	ChallengeEnabled bool   `json:"challengeEnabled"`
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_detail
+func (c *Client) CreateGameCenter(ctx context.Context, appID string, gc *GameCenter) (*Resource[GameCenter], error) {
+	url := c.baseURL + resourceTypeGameCenters
+	req := createResource{
+		Type: resourceTypeGameCenters,
+		Attr: gc,
+		Relations: map[string]relation{
+			"app": relationTo(resourceTypeApps, appID),
+		},
+	}
+
+	resp, err := doCreate[GameCenter](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create game center: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_state_of_game_center_for_an_app
+func (c *Client) GetGameCenter(ctx context.Context, appID string) (*Resource[GameCenter], error) {
+	url := c.baseURL + resourceTypeApps + "/" + appID + "/gameCenterDetail"
+
+	resp, err := doGet[GameCenter](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game center: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetGameCenterByID includes the app relationship in the response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_details_information
+func (c *Client) GetGameCenterByID(ctx context.Context, id string) (*Resource[GameCenter], error) {
+	url := c.baseURL + resourceTypeGameCenters + "/" + id + "?include=app"
+
+	resp, err := doGet[GameCenter](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game center: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_detail
+func (c *Client) UpdateGameCenter(ctx context.Context, upd GameCenterUpdate) (*Resource[GameCenter], error) {
+	url := c.baseURL + resourceTypeGameCenters + "/" + upd.ID
+	req := updateResource{
+		ID:   upd.ID,
+		Type: resourceTypeGameCenters,
+		Attr: upd,
+	}
+
+	resp, err := doUpdate[GameCenter](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update game center: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetGameCenterAchievementOrder returns identifiers of the achievements of the game center, in the order they appear to players.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterdetails-_id_-relationships-gamecenterachievements
+func (c *Client) GetGameCenterAchievementOrder(ctx context.Context, id string) ([]string, error) {
+	url := c.baseURL + resourceTypeGameCenters + "/" + id + "/relationships/gameCenterAchievements"
+
+	linkages, err := doGetLinkages(c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game center achievement order: %w", err)
+	}
+
+	return linkageIDs(linkages), nil
+}
+
+// ReplaceGameCenterAchievementOrder reorders the achievements of the game center, the identifiers must list every
+// achievement of the game center exactly once.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-gamecenterdetails-_id_-relationships-gamecenterachievements
+func (c *Client) ReplaceGameCenterAchievementOrder(ctx context.Context, id string, achievementIDs []string) error {
+	url := c.baseURL + resourceTypeGameCenters + "/" + id + "/relationships/gameCenterAchievements"
+
+	if err := doReplaceLinkages(c, ctx, url, linkagesTo(resourceTypeAchievements, achievementIDs)); err != nil {
+		return fmt.Errorf("failed to replace game center achievement order: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alexprogrammr/appstore-go"
+)
+
+const (
+	resourceTypeLeaderboardImages = "gameCenterLeaderboardImages"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_leaderboard_image
+func (c *Client) CreateLeaderboardImage(ctx context.Context, localizationID string, name string, data []byte) (*Resource[appstore.Asset], error) {
+	relations := map[string]relation{
+		"gameCenterLeaderboardLocalization": relationTo(resourceTypeLeaderboardLocalizations, localizationID),
+	}
+
+	asset, err := c.createAsset(ctx, resourceTypeLeaderboardImages, relations, name, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create leaderboard image: %w", err)
+	}
+
+	return asset, nil
+}
+
+// GetLeaderboardImageByID includes the leaderboard localization relationship in the response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_leaderboard_image_information
+func (c *Client) GetLeaderboardImageByID(ctx context.Context, id string) (*Resource[appstore.Asset], error) {
+	url := c.baseURL + resourceTypeLeaderboardImages + "/" + id + "?include=gameCenterLeaderboardLocalization"
+
+	resp, err := doGet[appstore.Asset](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard image: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_leaderboard_image
+func (c *Client) DeleteLeaderboardImageByID(ctx context.Context, id string) error {
+	url := c.baseURL + resourceTypeLeaderboardImages + "/" + id
+
+	if err := doDelete(c, ctx, url); err != nil {
+		return fmt.Errorf("failed to delete leaderboard image: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	resourceTypeLeaderboardLocalizations = "gameCenterLeaderboardLocalizations"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardlocalization/attributes
+type LeaderboardLocalization struct {
+	Locale                  string  `json:"locale"`
+	Name                    string  `json:"name"`
+	FormatterOverride       *string `json:"formatterOverride,omitempty"`
+	FormatterSuffix         *string `json:"formatterSuffix,omitempty"`
+	FormatterSuffixSingular *string `json:"formatterSuffixSingular,omitempty"`
+}
+
+// LeaderboardLocalizationUpdate sends every attribute, so that optional values
+// that are nil are cleared remotely instead of being left untouched.
+type LeaderboardLocalizationUpdate struct {
+	ID                      string  `json:"-"`
+	Name                    string  `json:"name"`
+	FormatterOverride       *string `json:"formatterOverride"`
+	FormatterSuffix         *string `json:"formatterSuffix"`
+	FormatterSuffixSingular *string `json:"formatterSuffixSingular"`
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_leaderboard_localization
+func (c *Client) CreateLeaderboardLocalization(ctx context.Context, leaderboardID string, loc *LeaderboardLocalization) (*Resource[LeaderboardLocalization], error) {
+	url := c.baseURL + resourceTypeLeaderboardLocalizations
+	req := createResource{
+		Type: resourceTypeLeaderboardLocalizations,
+		Attr: loc,
+		Relations: map[string]relation{
+			"gameCenterLeaderboard": relationTo(resourceTypeLeaderboards, leaderboardID),
+		},
+	}
+
+	resp, err := doCreate[LeaderboardLocalization](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create leaderboard localization: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetLeaderboardLocalizationByID includes the leaderboard relationship in the response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_leaderboard_localization_information
+func (c *Client) GetLeaderboardLocalizationByID(ctx context.Context, id string) (*Resource[LeaderboardLocalization], error) {
+	url := c.baseURL + resourceTypeLeaderboardLocalizations + "/" + id + "?include=gameCenterLeaderboard"
+
+	resp, err := doGet[LeaderboardLocalization](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard localization: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_a_leaderboard
+func (c *Client) ListLeaderboardLocalizations(ctx context.Context, leaderboardID string) ([]Resource[LeaderboardLocalization], error) {
+	url := c.baseURL + resourceTypeLeaderboards + "/" + leaderboardID + "/localizations"
+
+	resp, err := doList[LeaderboardLocalization](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leaderboard localizations: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_leaderboard_localization
+func (c *Client) UpdateLeaderboardLocalization(ctx context.Context, upd LeaderboardLocalizationUpdate) (*Resource[LeaderboardLocalization], error) {
+	url := c.baseURL + resourceTypeLeaderboardLocalizations + "/" + upd.ID
+	req := updateResource{
+		ID:   upd.ID,
+		Type: resourceTypeLeaderboardLocalizations,
+		Attr: upd,
+	}
+
+	resp, err := doUpdate[LeaderboardLocalization](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update leaderboard localization: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_leaderboard_localization
+func (c *Client) DeleteLeaderboardLocalizationByID(ctx context.Context, id string) error {
+	url := c.baseURL + resourceTypeLeaderboardLocalizations + "/" + id
+
+	if err := doDelete(c, ctx, url); err != nil {
+		return fmt.Errorf("failed to delete leaderboard localization: %w", err)
+	}
+
+	return nil
+}
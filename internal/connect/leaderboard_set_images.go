@@ -0,0 +1,51 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alexprogrammr/appstore-go"
+)
+
+const (
+	resourceTypeLeaderboardSetImages = "gameCenterLeaderboardSetImages"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_leaderboard_set_image
+func (c *Client) CreateLeaderboardSetImage(ctx context.Context, localizationID string, name string, data []byte) (*Resource[appstore.Asset], error) {
+	relations := map[string]relation{
+		"gameCenterLeaderboardSetLocalization": relationTo(resourceTypeLeaderboardSetLocalizations, localizationID),
+	}
+
+	asset, err := c.createAsset(ctx, resourceTypeLeaderboardSetImages, relations, name, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create leaderboard set image: %w", err)
+	}
+
+	return asset, nil
+}
+
+// GetLeaderboardSetImageByID includes the leaderboard set localization relationship in the response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_leaderboard_set_image_information
+func (c *Client) GetLeaderboardSetImageByID(ctx context.Context, id string) (*Resource[appstore.Asset], error) {
+	url := c.baseURL + resourceTypeLeaderboardSetImages + "/" + id + "?include=gameCenterLeaderboardSetLocalization"
+
+	resp, err := doGet[appstore.Asset](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard set image: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_leaderboard_set_image
+func (c *Client) DeleteLeaderboardSetImageByID(ctx context.Context, id string) error {
+	url := c.baseURL + resourceTypeLeaderboardSetImages + "/" + id
+
+	if err := doDelete(c, ctx, url); err != nil {
+		return fmt.Errorf("failed to delete leaderboard set image: %w", err)
+	}
+
+	return nil
+}
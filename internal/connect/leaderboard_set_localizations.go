@@ -0,0 +1,94 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	resourceTypeLeaderboardSetLocalizations = "gameCenterLeaderboardSetLocalizations"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetlocalization/attributes
+type LeaderboardSetLocalization struct {
+	Locale string `json:"locale"`
+	Name   string `json:"name"`
+}
+
+type LeaderboardSetLocalizationUpdate struct {
+	ID   string `json:"-"`
+	Name string `json:"name"`
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_leaderboard_set_localization
+func (c *Client) CreateLeaderboardSetLocalization(ctx context.Context, setID string, loc *LeaderboardSetLocalization) (*Resource[LeaderboardSetLocalization], error) {
+	url := c.baseURL + resourceTypeLeaderboardSetLocalizations
+	req := createResource{
+		Type: resourceTypeLeaderboardSetLocalizations,
+		Attr: loc,
+		Relations: map[string]relation{
+			"gameCenterLeaderboardSet": relationTo(resourceTypeLeaderboardSets, setID),
+		},
+	}
+
+	resp, err := doCreate[LeaderboardSetLocalization](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create leaderboard set localization: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetLeaderboardSetLocalizationByID includes the leaderboard set relationship in the response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_leaderboard_set_localization_information
+func (c *Client) GetLeaderboardSetLocalizationByID(ctx context.Context, id string) (*Resource[LeaderboardSetLocalization], error) {
+	url := c.baseURL + resourceTypeLeaderboardSetLocalizations + "/" + id + "?include=gameCenterLeaderboardSet"
+
+	resp, err := doGet[LeaderboardSetLocalization](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard set localization: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_a_leaderboard_set
+func (c *Client) ListLeaderboardSetLocalizations(ctx context.Context, setID string) ([]Resource[LeaderboardSetLocalization], error) {
+	url := c.baseURL + resourceTypeLeaderboardSets + "/" + setID + "/localizations"
+
+	resp, err := doList[LeaderboardSetLocalization](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leaderboard set localizations: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_leaderboard_set_localization
+func (c *Client) UpdateLeaderboardSetLocalization(ctx context.Context, upd LeaderboardSetLocalizationUpdate) (*Resource[LeaderboardSetLocalization], error) {
+	url := c.baseURL + resourceTypeLeaderboardSetLocalizations + "/" + upd.ID
+	req := updateResource{
+		ID:   upd.ID,
+		Type: resourceTypeLeaderboardSetLocalizations,
+		Attr: upd,
+	}
+
+	resp, err := doUpdate[LeaderboardSetLocalization](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update leaderboard set localization: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_leaderboard_set_localization
+func (c *Client) DeleteLeaderboardSetLocalizationByID(ctx context.Context, id string) error {
+	url := c.baseURL + resourceTypeLeaderboardSetLocalizations + "/" + id
+
+	if err := doDelete(c, ctx, url); err != nil {
+		return fmt.Errorf("failed to delete leaderboard set localization: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	resourceTypeLeaderboardSets = "gameCenterLeaderboardSets"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardset/attributes
+type LeaderboardSet struct {
+	ReferenceName    string `json:"referenceName"`
+	VendorIdentifier string `json:"vendorIdentifier"`
+}
+
+type LeaderboardSetUpdate struct {
+	ID            string `json:"-"`
+	ReferenceName string `json:"referenceName"`
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_leaderboard_set
+func (c *Client) CreateLeaderboardSet(ctx context.Context, owner Owner, set *LeaderboardSet) (*Resource[LeaderboardSet], error) {
+	url := c.baseURL + resourceTypeLeaderboardSets
+	req := createResource{
+		Type:      resourceTypeLeaderboardSets,
+		Attr:      set,
+		Relations: owner.relations(),
+	}
+
+	resp, err := doCreate[LeaderboardSet](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create leaderboard set: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetLeaderboardSetByID includes the game center and the game center group relationships in the response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_leaderboard_set_information
+func (c *Client) GetLeaderboardSetByID(ctx context.Context, id string) (*Resource[LeaderboardSet], error) {
+	url := c.baseURL + resourceTypeLeaderboardSets + "/" + id + "?include=gameCenterDetail,gameCenterGroup"
+
+	resp, err := doGet[LeaderboardSet](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard set: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_leaderboard_set
+func (c *Client) UpdateLeaderboardSet(ctx context.Context, upd LeaderboardSetUpdate) (*Resource[LeaderboardSet], error) {
+	url := c.baseURL + resourceTypeLeaderboardSets + "/" + upd.ID
+	req := updateResource{
+		ID:   upd.ID,
+		Type: resourceTypeLeaderboardSets,
+		Attr: upd,
+	}
+
+	resp, err := doUpdate[LeaderboardSet](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update leaderboard set: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_leaderboard_set
+func (c *Client) DeleteLeaderboardSetByID(ctx context.Context, id string) error {
+	url := c.baseURL + resourceTypeLeaderboardSets + "/" + id
+
+	if err := doDelete(c, ctx, url); err != nil {
+		return fmt.Errorf("failed to delete leaderboard set: %w", err)
+	}
+
+	return nil
+}
+
+// GetLeaderboardSetMembers returns identifiers of the leaderboards in the set, in the order they appear to players.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_all_leaderboard_ids_for_a_leaderboard_set
+func (c *Client) GetLeaderboardSetMembers(ctx context.Context, id string) ([]string, error) {
+	url := c.baseURL + resourceTypeLeaderboardSets + "/" + id + "/relationships/gameCenterLeaderboards"
+
+	linkages, err := doGetLinkages(c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard set members: %w", err)
+	}
+
+	return linkageIDs(linkages), nil
+}
+
+// ReplaceLeaderboardSetMembers replaces the leaderboards in the set, the order of the identifiers defines the order of the leaderboards.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/replace_all_leaderboards_in_a_leaderboard_set
+func (c *Client) ReplaceLeaderboardSetMembers(ctx context.Context, id string, leaderboardIDs []string) error {
+	url := c.baseURL + resourceTypeLeaderboardSets + "/" + id + "/relationships/gameCenterLeaderboards"
+
+	if err := doReplaceLinkages(c, ctx, url, linkagesTo(resourceTypeLeaderboards, leaderboardIDs)); err != nil {
+		return fmt.Errorf("failed to replace leaderboard set members: %w", err)
+	}
+
+	return nil
+}
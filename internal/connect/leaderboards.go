@@ -0,0 +1,103 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	resourceTypeLeaderboards = "gameCenterLeaderboards"
+)
+
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboard/attributes
+type Leaderboard struct {
+	ReferenceName       string  `json:"referenceName"`
+	VendorIdentifier    string  `json:"vendorIdentifier"`
+	DefaultFormatter    string  `json:"defaultFormatter"`
+	SubmissionType      string  `json:"submissionType"`
+	ScoreSortType       string  `json:"scoreSortType"`
+	ScoreRangeStart     *string `json:"scoreRangeStart,omitempty"`
+	ScoreRangeEnd       *string `json:"scoreRangeEnd,omitempty"`
+	RecurrenceStartDate *string `json:"recurrenceStartDate,omitempty"`
+	RecurrenceDuration  *string `json:"recurrenceDuration,omitempty"`
+	Archived            bool    `json:"archived,omitempty"`
+}
+
+// LeaderboardUpdate sends every attribute, so that optional values that are
+// nil are cleared remotely instead of being left untouched.
+type LeaderboardUpdate struct {
+	ID                  string  `json:"-"`
+	ReferenceName       string  `json:"referenceName"`
+	DefaultFormatter    string  `json:"defaultFormatter"`
+	SubmissionType      string  `json:"submissionType"`
+	ScoreSortType       string  `json:"scoreSortType"`
+	ScoreRangeStart     *string `json:"scoreRangeStart"`
+	ScoreRangeEnd       *string `json:"scoreRangeEnd"`
+	RecurrenceStartDate *string `json:"recurrenceStartDate"`
+	RecurrenceDuration  *string `json:"recurrenceDuration"`
+	Archived            bool    `json:"archived"`
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_leaderboard
+func (c *Client) CreateLeaderboard(ctx context.Context, owner Owner, lb *Leaderboard) (*Resource[Leaderboard], error) {
+	url := c.baseURL + resourceTypeLeaderboards
+
+	// Leaderboards can only be archived after they have been created.
+	attr := *lb
+	attr.Archived = false
+
+	req := createResource{
+		Type:      resourceTypeLeaderboards,
+		Attr:      attr,
+		Relations: owner.relations(),
+	}
+
+	resp, err := doCreate[Leaderboard](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create leaderboard: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetLeaderboardByID includes the game center and the game center group relationships in the response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_leaderboard_information
+func (c *Client) GetLeaderboardByID(ctx context.Context, id string) (*Resource[Leaderboard], error) {
+	url := c.baseURL + resourceTypeLeaderboards + "/" + id + "?include=gameCenterDetail,gameCenterGroup"
+
+	resp, err := doGet[Leaderboard](c, ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_leaderboard
+func (c *Client) UpdateLeaderboard(ctx context.Context, upd LeaderboardUpdate) (*Resource[Leaderboard], error) {
+	url := c.baseURL + resourceTypeLeaderboards + "/" + upd.ID
+	req := updateResource{
+		ID:   upd.ID,
+		Type: resourceTypeLeaderboards,
+		Attr: upd,
+	}
+
+	resp, err := doUpdate[Leaderboard](c, ctx, url, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update leaderboard: %w", err)
+	}
+
+	return resp, nil
+}
+
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_leaderboard
+func (c *Client) DeleteLeaderboardByID(ctx context.Context, id string) error {
+	url := c.baseURL + resourceTypeLeaderboards + "/" + id
+
+	if err := doDelete(c, ctx, url); err != nil {
+		return fmt.Errorf("failed to delete leaderboard: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package connect
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+type limitTransport struct {
+	base  http.RoundTripper
+	slots chan struct{}
+}
+
+// NewLimitTransport wraps the transport so that at most max requests are in flight at the same time. A request holds
+// its slot until the response body is closed, queued requests give up when their context is done.
+func NewLimitTransport(base http.RoundTripper, max int) http.RoundTripper {
+	return &limitTransport{
+		base:  base,
+		slots: make(chan struct{}, max),
+	}
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.slots <- struct{}{}:
+	case <-req.Context().Done():
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, req.Context().Err()
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		t.release()
+		return nil, err
+	}
+
+	resp.Body = &limitBody{ReadCloser: resp.Body, release: t.release}
+	return resp, nil
+}
+
+func (t *limitTransport) release() {
+	<-t.slots
+}
+
+type limitBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *limitBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
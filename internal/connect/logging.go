@@ -0,0 +1,167 @@
+package connect
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem of the HTTP traffic. Its level follows the provider log level unless it is set
+// with the TF_LOG_PROVIDER_APPSTORE_HTTP environment variable.
+const LogSubsystem = "appstore"
+
+// maxLoggedBody is the number of bytes of a JSON body included in the logs, longer bodies are truncated.
+const maxLoggedBody = 4096
+
+// logLevelVariables are the environment variables that set the level of the HTTP logs, from the most specific one.
+var logLevelVariables = []string{"TF_LOG_PROVIDER_APPSTORE_HTTP", "TF_LOG_PROVIDER_APPSTORE", "TF_LOG_PROVIDER", "TF_LOG"}
+
+// loggedHeaders are the response headers logged as separate fields, as they tell how close the key is to its limits.
+var loggedHeaders = []string{"X-Rate-Limit", "Retry-After"}
+
+type loggingTransport struct {
+	base   http.RoundTripper
+	bodies bool
+}
+
+// NewLoggingTransport wraps the transport so that every request and response is logged at the debug level. The
+// Authorization header, query of pre-signed upload URLs and non-JSON bodies, such as uploaded images, are redacted.
+// Bodies are read for the logs only when the environment enables debug logs, and only up to maxLoggedBody bytes.
+func NewLoggingTransport(base http.RoundTripper) http.RoundTripper {
+	return &loggingTransport{
+		base:   base,
+		bodies: debugLogging(),
+	}
+}
+
+// debugLogging reports whether the environment sets the level of the HTTP logs to debug or trace, the level is
+// taken from the most specific variable the same way Terraform and tflog do.
+func debugLogging() bool {
+	for _, name := range logLevelVariables {
+		switch level := strings.ToUpper(os.Getenv(name)); level {
+		case "":
+			continue
+		case "TRACE", "DEBUG", "JSON":
+			return true
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", "APPSTORE", "HTTP"))
+	ctx = tflog.SubsystemSetField(ctx, LogSubsystem, "http.method", req.Method)
+	ctx = tflog.SubsystemSetField(ctx, LogSubsystem, "http.url", redactURL(req))
+
+	fields := map[string]any{
+		"http.request.headers": redactHeaders(req.Header),
+	}
+	if t.bodies && req.GetBody != nil && req.Body != nil && req.Body != http.NoBody {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(io.LimitReader(body, maxLoggedBody+1))
+			body.Close()
+			fields["http.request.body"] = redactBody(req.Header.Get("Content-Type"), data, req.ContentLength)
+		}
+	}
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending App Store Connect request", fields)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		tflog.SubsystemDebug(ctx, LogSubsystem, "App Store Connect request failed", map[string]any{
+			"http.duration_ms": time.Since(start).Milliseconds(),
+			"error":            err.Error(),
+		})
+		return nil, err
+	}
+
+	fields = map[string]any{
+		"http.status_code":      resp.StatusCode,
+		"http.duration_ms":      time.Since(start).Milliseconds(),
+		"http.response.headers": redactHeaders(resp.Header),
+	}
+	if t.bodies {
+		// Only the logged part of the body is read ahead, the caller reads it again followed by the rest of the body.
+		data, err := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBody+1))
+		if err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to read response: %w", err)
+		}
+		resp.Body = &prefixedBody{Reader: io.MultiReader(bytes.NewReader(data), resp.Body), Closer: resp.Body}
+
+		fields["http.response.body"] = redactBody(resp.Header.Get("Content-Type"), data, resp.ContentLength)
+	}
+	for _, name := range loggedHeaders {
+		if value := resp.Header.Get(name); value != "" {
+			fields["http.response.header."+name] = value
+		}
+	}
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received App Store Connect response", fields)
+
+	return resp, nil
+}
+
+// redactURL removes the query of requests without the Authorization header, as pre-signed upload URLs carry their
+// credentials in the query.
+func redactURL(req *http.Request) string {
+	if req.Header.Get("Authorization") != "" || req.URL.RawQuery == "" {
+		return req.URL.String()
+	}
+
+	u := *req.URL
+	u.RawQuery = "redacted"
+	return u.String()
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for name := range header {
+		value := header.Get(name)
+		if name == "Authorization" {
+			value = "Bearer <redacted>"
+		}
+		redacted[name] = value
+	}
+
+	return redacted
+}
+
+// redactBody returns the logged form of the body read up to maxLoggedBody bytes, size is the length of the whole
+// body or -1 if unknown.
+func redactBody(contentType string, data []byte, size int64) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType != "application/json" && mediaType != "application/vnd.api+json" {
+		if size < 0 {
+			return fmt.Sprintf("<redacted body of %s>", contentType)
+		}
+		return fmt.Sprintf("<redacted %d bytes of %s>", size, contentType)
+	}
+
+	if len(data) > maxLoggedBody {
+		if size < 0 {
+			return fmt.Sprintf("%s... <truncated>", data[:maxLoggedBody])
+		}
+		return fmt.Sprintf("%s... <truncated %d bytes>", data[:maxLoggedBody], size-maxLoggedBody)
+	}
+
+	return string(data)
+}
+
+// prefixedBody is a response body with its beginning read ahead for the logs.
+type prefixedBody struct {
+	io.Reader
+	io.Closer
+}
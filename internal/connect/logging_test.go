@@ -0,0 +1,150 @@
+package connect
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Rate-Limit", "user-hour-lim:3600;user-hour-rem:3599;")
+		io.WriteString(w, `{"data":{"type":"apps","id":"1"}}`)
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv("TF_LOG_PROVIDER_APPSTORE_HTTP", "DEBUG")
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	client := &http.Client{Transport: NewLoggingTransport(http.DefaultTransport)}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, srv.URL+"/v1/apps/1", strings.NewReader(`{"data":{"type":"apps"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer secret-token")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if string(body) != `{"data":{"type":"apps","id":"1"}}` {
+		t.Errorf("got response body %s after logging", body)
+	}
+
+	upload, err := http.NewRequestWithContext(ctx, http.MethodPut, srv.URL+"/upload?signature=secret-signature", bytes.NewReader([]byte("\x89PNG")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	upload.Header.Set("Content-Type", "image/png")
+
+	resp, err = client.Do(upload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	out := logs.String()
+	for _, secret := range []string{"secret-token", "secret-signature", "PNG"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("logs contain %q:\n%s", secret, out)
+		}
+	}
+	for _, want := range []string{
+		`"@module":"provider.appstore"`,
+		`"http.method":"PATCH"`,
+		`"http.status_code":200`,
+		`"http.response.header.X-Rate-Limit":"user-hour-lim:3600;user-hour-rem:3599;"`,
+		`"http.request.body":"{\"data\":{\"type\":\"apps\"}}"`,
+		`"http.request.body":"\u003credacted 4 bytes of image/png\u003e"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("logs do not contain %s:\n%s", want, out)
+		}
+	}
+}
+
+func TestLoggingTransportTruncatesBody(t *testing.T) {
+	large := `{"data":"` + strings.Repeat("a", 2*maxLoggedBody) + `"}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Length", strconv.Itoa(len(large)))
+		io.WriteString(w, large)
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv("TF_LOG_PROVIDER_APPSTORE_HTTP", "TRACE")
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	client := &http.Client{Transport: NewLoggingTransport(http.DefaultTransport)}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/v1/apps", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if string(body) != large {
+		t.Errorf("got response body of %d bytes after logging, want %d bytes", len(body), len(large))
+	}
+
+	want := fmt.Sprintf(`... \u003ctruncated %d bytes\u003e"`, len(large)-maxLoggedBody)
+	if out := logs.String(); !strings.Contains(out, want) || strings.Contains(out, large) {
+		t.Errorf("logs do not contain the truncated body:\n%s", out)
+	}
+}
+
+func TestLoggingTransportDebugDisabled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"data":{"type":"apps","id":"1"}}`)
+	}))
+	t.Cleanup(srv.Close)
+	for _, name := range logLevelVariables {
+		t.Setenv(name, "")
+	}
+	t.Setenv("TF_LOG", "INFO")
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+	client := &http.Client{Transport: NewLoggingTransport(http.DefaultTransport)}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/v1/apps", strings.NewReader(`{"data":{"type":"apps"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if string(body) != `{"data":{"type":"apps","id":"1"}}` {
+		t.Errorf("got response body %s", body)
+	}
+	if out := logs.String(); strings.Contains(out, "http.request.body") || strings.Contains(out, "http.response.body") {
+		t.Errorf("logs contain bodies with debug logging disabled:\n%s", out)
+	}
+}
@@ -0,0 +1,156 @@
+package connect
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+func (c *Client) do(ctx context.Context, method, url string, in any, status int, out any) error {
+	var body io.Reader = http.NoBody
+	if in != nil {
+		data, err := json.Marshal(struct {
+			Data any `json:"data"`
+		}{in})
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	token, err := c.tokenSource.Token()
+	if err != nil {
+		return fmt.Errorf("failed to get token: %w", err)
+	}
+
+	// The header marks the request as one to the API, NewAuthTransport signs every attempt of it again.
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != status {
+		apiErr := &Error{StatusCode: resp.StatusCode}
+
+		// Error details are optional, the status code alone is reported if the body is not a JSON:API error document.
+		rp := struct {
+			Errors []ErrorObject `json:"errors"`
+		}{}
+		if err := json.NewDecoder(resp.Body).Decode(&rp); err == nil {
+			apiErr.Errors = rp.Errors
+		}
+
+		return apiErr
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+func doGet[T any](c *Client, ctx context.Context, url string) (*Resource[T], error) {
+	resource, _, err := doGetIncluded[T](c, ctx, url)
+	return resource, err
+}
+
+// doGetIncluded returns the resource together with the related resources requested by the include parameter of
+// the url.
+func doGetIncluded[T any](c *Client, ctx context.Context, url string) (*Resource[T], []includedResource, error) {
+	rp := new(response[Resource[T]])
+	if err := c.do(ctx, http.MethodGet, url, nil, http.StatusOK, rp); err != nil {
+		return nil, nil, err
+	}
+
+	return &rp.Data, rp.Included, nil
+}
+
+func doList[T any](c *Client, ctx context.Context, url string) ([]Resource[T], error) {
+	resources, _, err := doListIncluded[T](c, ctx, url)
+	return resources, err
+}
+
+// doListIncluded returns the resources of every page together with the related resources requested by the include
+// parameter of the url.
+func doListIncluded[T any](c *Client, ctx context.Context, url string) ([]Resource[T], []includedResource, error) {
+	resources := []Resource[T]{}
+	included := []includedResource{}
+
+	for url != "" {
+		rp := new(response[[]Resource[T]])
+		if err := c.do(ctx, http.MethodGet, url, nil, http.StatusOK, rp); err != nil {
+			return nil, nil, err
+		}
+
+		resources = append(resources, rp.Data...)
+		included = append(included, rp.Included...)
+		url = rp.Links.Next
+	}
+
+	return resources, included, nil
+}
+
+func doCreate[T any](c *Client, ctx context.Context, url string, resource createResource) (*Resource[T], error) {
+	rp := new(response[Resource[T]])
+	if err := c.do(ctx, http.MethodPost, url, resource, http.StatusCreated, rp); err != nil {
+		return nil, err
+	}
+
+	return &rp.Data, nil
+}
+
+func doUpdate[T any](c *Client, ctx context.Context, url string, resource updateResource) (*Resource[T], error) {
+	rp := new(response[Resource[T]])
+	if err := c.do(ctx, http.MethodPatch, url, resource, http.StatusOK, rp); err != nil {
+		return nil, err
+	}
+
+	return &rp.Data, nil
+}
+
+func doDelete(c *Client, ctx context.Context, url string) error {
+	return c.do(ctx, http.MethodDelete, url, nil, http.StatusNoContent, nil)
+}
+
+func doGetLinkages(c *Client, ctx context.Context, url string) ([]linkage, error) {
+	linkages := []linkage{}
+
+	for url != "" {
+		rp := new(response[[]linkage])
+		if err := c.do(ctx, http.MethodGet, url, nil, http.StatusOK, rp); err != nil {
+			return nil, err
+		}
+
+		linkages = append(linkages, rp.Data...)
+		url = rp.Links.Next
+	}
+
+	return linkages, nil
+}
+
+func doReplaceLinkages(c *Client, ctx context.Context, url string, linkages []linkage) error {
+	if linkages == nil {
+		linkages = []linkage{}
+	}
+
+	return c.do(ctx, http.MethodPatch, url, linkages, http.StatusNoContent, nil)
+}
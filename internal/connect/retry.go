@@ -0,0 +1,185 @@
+package connect
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy configures how requests are retried on rate limiting, server errors and network failures.
+type RetryPolicy struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+type retryTransport struct {
+	base   http.RoundTripper
+	policy RetryPolicy
+}
+
+// NewRetryTransport wraps the transport with retries. Rate limited requests are always retried, other failures
+// only for idempotent requests, so that a resource is never created twice. The wait between attempts follows the
+// Retry-After header when present and exponential backoff otherwise, but never exceeds MaxBackoff: a response asking
+// to wait longer is returned as is. A rate limited request fails right away once the hourly quota is exhausted.
+func NewRetryTransport(base http.RoundTripper, policy RetryPolicy) http.RoundTripper {
+	return &retryTransport{
+		base:   base,
+		policy: policy,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		// The request of the caller must not be modified, every retry sends a copy with a fresh body.
+		attemptReq := req
+		if attempt > 0 {
+			var err error
+			if attemptReq, err = cloneRequest(req); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if err == nil && quotaExhausted(resp) {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			return nil, fmt.Errorf("hourly quota exhausted (X-Rate-Limit: %s), retry after the quota resets", resp.Header.Get("X-Rate-Limit"))
+		}
+		if attempt >= t.policy.MaxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait, ok := t.backoff(attempt, resp)
+		if !ok {
+			return resp, err
+		}
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	// Request body cannot be sent again.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if req.Method == http.MethodPost {
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// cloneRequest returns a copy of the request with a new body, so that it can be sent again.
+func cloneRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+
+	return clone, nil
+}
+
+// backoff returns the wait before the next attempt, or false if the server asks to wait longer than MaxBackoff.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait, wait <= t.policy.MaxBackoff
+		}
+	}
+
+	wait := t.policy.MinBackoff << attempt
+	if wait <= 0 || wait > t.policy.MaxBackoff {
+		wait = t.policy.MaxBackoff
+	}
+
+	// Add jitter so that parallel requests do not retry in lockstep.
+	if half := int64(wait / 2); half > 0 {
+		wait = time.Duration(half + rand.Int63n(half))
+	}
+
+	return wait, true
+}
+
+// retryAfter parses the Retry-After header, which is either a number of seconds or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// quotaExhausted reports whether the request was rate limited because no requests remain in the hourly quota, there
+// is no point retrying before the quota resets.
+func quotaExhausted(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusTooManyRequests && rateLimitRemaining(resp.Header.Get("X-Rate-Limit")) == 0
+}
+
+// rateLimitRemaining parses the X-Rate-Limit header, for example, "user-hour-lim:3600;user-hour-rem:0;",
+// and returns the number of remaining requests or -1 if it is unknown.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/identifying_rate_limits
+func rateLimitRemaining(value string) int {
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok || key != "user-hour-rem" {
+			continue
+		}
+
+		if remaining, err := strconv.Atoi(val); err == nil {
+			return remaining
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,132 @@
+package connect
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// retryServer responds with the rate limit error and the Retry-After header to the first request and with 200 OK
+// to the following ones, and records the bodies of the requests.
+func retryServer(t *testing.T, retryAfter string) (*httptest.Server, *atomic.Int32, *[]string) {
+	t.Helper()
+
+	var calls atomic.Int32
+	bodies := []string{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &calls, &bodies
+}
+
+func TestRetryTransportRetryAfter(t *testing.T) {
+	srv, calls, bodies := retryServer(t, "1")
+
+	transport := NewRetryTransport(http.DefaultTransport, RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Second})
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(`{"data":{}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := req.Body
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("got %d attempts, want 2", calls.Load())
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, want at least the Retry-After of 1s", elapsed)
+	}
+	if strings.Join(*bodies, ",") != `{"data":{}},{"data":{}}` {
+		t.Errorf("got bodies %q, want the body sent twice", *bodies)
+	}
+	if req.Body != body {
+		t.Error("the body of the request was replaced")
+	}
+}
+
+func TestRetryTransportRetryAfterExceedsMaxBackoff(t *testing.T) {
+	srv, calls, _ := retryServer(t, "3600")
+
+	transport := NewRetryTransport(http.DefaultTransport, RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Second})
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("got status %d, want %d", resp.StatusCode, http.StatusTooManyRequests)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("got %d attempts, want 1", calls.Load())
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("returned after %s, want the response without waiting", elapsed)
+	}
+}
+
+func TestRetryTransportQuotaExhausted(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("X-Rate-Limit", "user-hour-lim:3600;user-hour-rem:0;")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(srv.Close)
+
+	transport := NewRetryTransport(http.DefaultTransport, RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Second})
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("got no error, want the exhausted quota error")
+	}
+
+	if !strings.Contains(err.Error(), "hourly quota exhausted") {
+		t.Errorf("got error %q, want the exhausted quota error", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("got %d attempts, want 1", calls.Load())
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("returned after %s, want the error without waiting", elapsed)
+	}
+}
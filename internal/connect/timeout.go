@@ -0,0 +1,47 @@
+package connect
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+type timeoutTransport struct {
+	base    http.RoundTripper
+	timeout time.Duration
+}
+
+// NewTimeoutTransport wraps the transport so that every attempt of a request, including upload of its body and
+// download of the response, fails once the timeout elapses. Unlike http.Client.Timeout, the timeout applies to
+// each retry separately and does not include the time a request waits for its turn.
+func NewTimeoutTransport(base http.RoundTripper, timeout time.Duration) http.RoundTripper {
+	return &timeoutTransport{
+		base:    base,
+		timeout: timeout,
+	}
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resp.Body = &timeoutBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type timeoutBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *timeoutBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
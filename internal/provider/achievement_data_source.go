@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &achievementDataSource{}
+	_ datasource.DataSourceWithConfigure        = &achievementDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &achievementDataSource{}
+)
+
+type achievementDataSourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	GameCenterID      types.String   `tfsdk:"game_center_id"`
+	GameCenterGroupID types.String   `tfsdk:"game_center_group_id"`
+	ReferenceName     types.String   `tfsdk:"reference_name"`
+	VendorID          types.String   `tfsdk:"vendor_id"`
+	Points            types.Int64    `tfsdk:"points"`
+	Repeatable        types.Bool     `tfsdk:"repeatable"`
+	ShowBeforeEarned  types.Bool     `tfsdk:"show_before_earned"`
+	Archived          types.Bool     `tfsdk:"archived"`
+	Live              types.Bool     `tfsdk:"live"`
+	LocalizationIDs   []types.String `tfsdk:"localization_ids"`
+}
+
+type achievementDataSource struct {
+	client *connect.Client
+}
+
+func NewAchievementDataSource() datasource.DataSource {
+	return &achievementDataSource{}
+}
+
+func (d *achievementDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_achievement"
+}
+
+func (d *achievementDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*connect.Client)
+}
+
+func (d *achievementDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches game center achievement from the App Store Connect, either by its identifier, " +
+			"or by the game center or the game center group and the vendor identifier.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the achievement. Conflicts with vendor_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"game_center_id": schema.StringAttribute{
+				Description: "Identifier of the game center the achievement is associated with. " +
+					"Either this or game_center_group_id is required with vendor_id.",
+				Optional: true,
+				Computed: true,
+			},
+			"game_center_group_id": schema.StringAttribute{
+				Description: "Identifier of the game center group the achievement is associated with. " +
+					"Either this or game_center_id is required with vendor_id.",
+				Optional: true,
+				Computed: true,
+			},
+			"reference_name": schema.StringAttribute{
+				Description: "An internal name of the achievement.",
+				Computed:    true,
+			},
+			"vendor_id": schema.StringAttribute{
+				Description: "A chosen identifier of the achievement to look it up by. Conflicts with id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"points": schema.Int64Attribute{
+				Description: "The points that the achievement is worth.",
+				Computed:    true,
+			},
+			"repeatable": schema.BoolAttribute{
+				Description: "An indication of whether the player can earn the achievement multiple times.",
+				Computed:    true,
+			},
+			"show_before_earned": schema.BoolAttribute{
+				Description: "An indication of whether the achievement is visible to the player before it is earned.",
+				Computed:    true,
+			},
+			"archived": schema.BoolAttribute{
+				Description: "An indication of whether the achievement is archived.",
+				Computed:    true,
+			},
+			"live": schema.BoolAttribute{
+				Description: "An indication of whether any release of the achievement is live.",
+				Computed:    true,
+			},
+			"localization_ids": schema.ListAttribute{
+				Description: "Identifiers of the localizations of the achievement.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *achievementDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("vendor_id"),
+		),
+		// The owner is given only to look the achievement up by the vendor identifier.
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("game_center_id"),
+			path.MatchRoot("game_center_group_id"),
+		),
+	}
+}
+
+func (d *achievementDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	state := achievementDataSourceModel{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if state.ID.IsNull() {
+		owner := connect.Owner{GameCenterID: state.GameCenterID.ValueString(), GroupID: state.GameCenterGroupID.ValueString()}
+
+		achievements, err := d.client.ListAchievements(ctx, owner)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Failed to read achievements", err, nil)
+			return
+		}
+
+		for _, achievement := range achievements {
+			if achievement.Attr.VendorIdentifier == state.VendorID.ValueString() {
+				id = achievement.ID
+				break
+			}
+		}
+		if id == "" {
+			where := fmt.Sprintf("game center %q", owner.GameCenterID)
+			if owner.GroupID != "" {
+				where = fmt.Sprintf("game center group %q", owner.GroupID)
+			}
+
+			resp.Diagnostics.AddError(
+				"Achievement not found",
+				fmt.Sprintf("Achievement with vendor identifier %q does not exist in the %s.", state.VendorID.ValueString(), where),
+			)
+			return
+		}
+	}
+
+	achievement, err := d.client.GetAchievementDetails(ctx, id)
+	if connect.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Achievement not found",
+			fmt.Sprintf("Achievement with identifier %q does not exist.", id),
+		)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read achievement", err, nil)
+		return
+	}
+
+	data := readAchievementData(achievement)
+
+	state.ID = data.ID
+	state.GameCenterID, state.GameCenterGroupID = ownerValues(&achievement.Resource)
+	state.ReferenceName = data.ReferenceName
+	state.VendorID = data.VendorID
+	state.Points = data.Points
+	state.Repeatable = data.Repeatable
+	state.ShowBeforeEarned = data.ShowBeforeEarned
+	state.Archived = data.Archived
+	state.Live = data.Live
+	state.LocalizationIDs = []types.String{}
+	for _, loc := range data.Localizations {
+		state.LocalizationIDs = append(state.LocalizationIDs, loc.ID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
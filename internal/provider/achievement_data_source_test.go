@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAchievementDataSource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	achievementID := srv.Add("gameCenterAchievements", map[string]any{
+		"referenceName":    "First Win",
+		"vendorIdentifier": "com.example.test.first_win",
+		"points":           10,
+		"repeatable":       false,
+		"showBeforeEarned": true,
+	}, map[string]string{"gameCenterDetail": gameCenterID})
+	localizationID := srv.Add("gameCenterAchievementLocalizations", map[string]any{
+		"locale": "en-US",
+		"name":   "First Win",
+	}, map[string]string{"gameCenterAchievement": achievementID})
+	srv.Add("gameCenterAchievementReleases", map[string]any{"live": true}, map[string]string{
+		"gameCenterAchievement": achievementID,
+		"gameCenterDetail":      gameCenterID,
+	})
+
+	groupID := srv.Add("gameCenterGroups", map[string]any{"referenceName": "Shared"}, nil)
+	sharedID := srv.Add("gameCenterAchievements", map[string]any{
+		"referenceName":    "Shared Win",
+		"vendorIdentifier": "com.example.test.shared_win",
+		"points":           20,
+	}, map[string]string{"gameCenterGroup": groupID})
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr("data.appstore_achievement.test", "id", achievementID),
+		resource.TestCheckResourceAttr("data.appstore_achievement.test", "game_center_id", gameCenterID),
+		resource.TestCheckNoResourceAttr("data.appstore_achievement.test", "game_center_group_id"),
+		resource.TestCheckResourceAttr("data.appstore_achievement.test", "reference_name", "First Win"),
+		resource.TestCheckResourceAttr("data.appstore_achievement.test", "vendor_id", "com.example.test.first_win"),
+		resource.TestCheckResourceAttr("data.appstore_achievement.test", "points", "10"),
+		resource.TestCheckResourceAttr("data.appstore_achievement.test", "repeatable", "false"),
+		resource.TestCheckResourceAttr("data.appstore_achievement.test", "show_before_earned", "true"),
+		resource.TestCheckResourceAttr("data.appstore_achievement.test", "archived", "false"),
+		resource.TestCheckResourceAttr("data.appstore_achievement.test", "live", "true"),
+		resource.TestCheckResourceAttr("data.appstore_achievement.test", "localization_ids.#", "1"),
+		resource.TestCheckResourceAttr("data.appstore_achievement.test", "localization_ids.0", localizationID),
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(srv) + testAccAchievementDataSourceConfig(`vendor_id = "com.example.test.first_win"`),
+				ExpectError: regexp.MustCompile(`Missing Attribute Configuration`),
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementDataSourceConfig(fmt.Sprintf(`id        = %q
+  vendor_id = "com.example.test.first_win"`, achievementID)),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementDataSourceConfig(fmt.Sprintf(`game_center_id = %q
+  vendor_id      = "com.example.test.unknown"`, gameCenterID)),
+				ExpectError: regexp.MustCompile(`Achievement not found`),
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementDataSourceConfig(fmt.Sprintf(`id             = %q
+  game_center_id = %q`, achievementID, gameCenterID)),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementDataSourceConfig(fmt.Sprintf(`game_center_group_id = %q
+  vendor_id            = "com.example.test.first_win"`, groupID)),
+				ExpectError: regexp.MustCompile(`Achievement not found`),
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementDataSourceConfig(fmt.Sprintf(`game_center_group_id = %q
+  vendor_id            = "com.example.test.shared_win"`, groupID)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.appstore_achievement.test", "id", sharedID),
+					resource.TestCheckResourceAttr("data.appstore_achievement.test", "game_center_group_id", groupID),
+				),
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementDataSourceConfig(fmt.Sprintf(`id = %q`, sharedID)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.appstore_achievement.test", "game_center_group_id", groupID),
+					resource.TestCheckNoResourceAttr("data.appstore_achievement.test", "game_center_id"),
+					resource.TestCheckResourceAttr("data.appstore_achievement.test", "live", "false"),
+					resource.TestCheckResourceAttr("data.appstore_achievement.test", "localization_ids.#", "0"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementDataSourceConfig(fmt.Sprintf(`game_center_id = %q
+  vendor_id      = "com.example.test.first_win"`, gameCenterID)),
+				Check: check,
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementDataSourceConfig(fmt.Sprintf(`id = %q`, achievementID)),
+				Check:  check,
+			},
+		},
+	})
+}
+
+func testAccAchievementDataSourceConfig(lookup string) string {
+	return fmt.Sprintf(`
+data "appstore_achievement" "test" {
+  %s
+}
+`, lookup)
+}
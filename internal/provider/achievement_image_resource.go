@@ -5,17 +5,20 @@ import (
 	"os"
 	"path/filepath"
 
-	"github.com/alexprogrammr/appstore-go"
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource              = &achievementImageResource{}
-	_ resource.ResourceWithConfigure = &achievementImageResource{}
+	_ resource.Resource                = &achievementImageResource{}
+	_ resource.ResourceWithConfigure   = &achievementImageResource{}
+	_ resource.ResourceWithImportState = &achievementImageResource{}
 )
 
 type achievementImageResourceModel struct {
@@ -25,8 +28,15 @@ type achievementImageResourceModel struct {
 	Checksum      types.String `tfsdk:"checksum"`
 }
 
+// achievementImageAttributes maps App Store Connect attributes and relationships of the achievement image to the schema attributes.
+var achievementImageAttributes = map[string]string{
+	"gameCenterAchievementLocalization": "achievement_localization_id",
+	"fileName":                          "file",
+	"fileSize":                          "file",
+}
+
 type achievementImageResource struct {
-	client *appstore.Client
+	client *connect.Client
 }
 
 func NewAchievementImageResource() resource.Resource {
@@ -42,7 +52,7 @@ func (r *achievementImageResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	r.client = req.ProviderData.(*appstore.Client)
+	r.client = req.ProviderData.(*connect.Client)
 }
 
 func (r *achievementImageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -115,19 +125,13 @@ func (r *achievementImageResource) Create(ctx context.Context, req resource.Crea
 
 	achievement, err := r.client.GetAchievementLocalizationByID(ctx, achievementId)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to get achievement localization",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Failed to get achievement localization", err, nil)
 		return
 	}
 
-	asset, err := r.client.CreateAchievementImage(ctx, achievement, filepath.Base(filePath), image)
+	asset, err := r.client.CreateAchievementImage(ctx, achievement.ID, filepath.Base(filePath), image)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to create achievement image",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Failed to create achievement image", err, achievementImageAttributes)
 		return
 	}
 
@@ -145,27 +149,36 @@ func (r *achievementImageResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	file := state.File.ValueString()
-	if _, err := os.Stat(file); os.IsNotExist(err) {
-		state.File = types.StringValue("")
-		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	image, err := r.client.GetAchievementImageByID(ctx, state.ID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Achievement image not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
 		return
 	}
-
-	image, err := os.ReadFile(state.File.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to read image file",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Failed to read achievement image", err, achievementImageAttributes)
 		return
 	}
 
-	if state.Checksum.ValueString() != checksum(image) {
-		state.File = types.StringValue("")
-		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-		return
+	state.AchievementID = types.StringValue(image.RelatedID("gameCenterAchievementLocalization"))
+
+	// Source file of imported images is unknown, it stays empty until the image is re-created.
+	if !state.File.IsNull() {
+		changed, err := imageFileChanged(state.File.ValueString(), state.Checksum.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read image file",
+				err.Error(),
+			)
+			return
+		}
+
+		if changed {
+			state.File = types.StringValue("")
+		}
 	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *achievementImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -180,11 +193,12 @@ func (r *achievementImageResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	err := r.client.DeleteAchievementImageByID(ctx, state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to delete achievement image",
-			err.Error(),
-		)
+	if err != nil && !connect.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Failed to delete achievement image", err, achievementImageAttributes)
 		return
 	}
 }
+
+func (r *achievementImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -3,17 +3,19 @@ package provider
 import (
 	"context"
 
-	"github.com/alexprogrammr/appstore-go"
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource              = &achievementLocalizationResource{}
-	_ resource.ResourceWithConfigure = &achievementLocalizationResource{}
+	_ resource.Resource                = &achievementLocalizationResource{}
+	_ resource.ResourceWithConfigure   = &achievementLocalizationResource{}
+	_ resource.ResourceWithImportState = &achievementLocalizationResource{}
 )
 
 type achievementLocalizationResourceModel struct {
@@ -25,8 +27,17 @@ type achievementLocalizationResourceModel struct {
 	AfterEarned   types.String `tfsdk:"after_earned_description"`
 }
 
+// achievementLocalizationAttributes maps App Store Connect attributes and relationships of the achievement localization to the schema attributes.
+var achievementLocalizationAttributes = map[string]string{
+	"gameCenterAchievement":   "achievement_id",
+	"locale":                  "locale",
+	"name":                    "name",
+	"beforeEarnedDescription": "before_earned_description",
+	"afterEarnedDescription":  "after_earned_description",
+}
+
 type achievementLocalizationResource struct {
-	client *appstore.Client
+	client *connect.Client
 }
 
 func NewAchievementLocalizationResource() resource.Resource {
@@ -42,7 +53,7 @@ func (r *achievementLocalizationResource) Configure(_ context.Context, req resou
 		return
 	}
 
-	r.client = req.ProviderData.(*appstore.Client)
+	r.client = req.ProviderData.(*connect.Client)
 }
 
 func (r *achievementLocalizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -105,24 +116,18 @@ func (r *achievementLocalizationResource) Create(ctx context.Context, req resour
 
 	achievement, err := r.client.GetAchievementByID(ctx, achievementID)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to read achievement",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Failed to read achievement", err, nil)
 		return
 	}
 
-	localization, err := r.client.CreateAchievementLocalization(ctx, achievement, &appstore.AchievementLocalization{
+	localization, err := r.client.CreateAchievementLocalization(ctx, achievement.ID, &connect.AchievementLocalization{
 		Locale:                  state.Locale.ValueString(),
 		Name:                    state.Name.ValueString(),
 		BeforeEarnedDescription: state.BeforeEarned.ValueString(),
 		AfterEarnedDescription:  state.AfterEarned.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to create achievement localization",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Failed to create achievement localization", err, achievementLocalizationAttributes)
 		return
 	}
 
@@ -139,14 +144,17 @@ func (r *achievementLocalizationResource) Read(ctx context.Context, req resource
 	}
 
 	localization, err := r.client.GetAchievementLocalizationByID(ctx, state.ID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Achievement localization not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to read achievement localization",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Failed to read achievement localization", err, achievementLocalizationAttributes)
 		return
 	}
 
+	state.AchievementID = types.StringValue(localization.RelatedID("gameCenterAchievement"))
 	state.Locale = types.StringValue(localization.Attr.Locale)
 	state.Name = types.StringValue(localization.Attr.Name)
 	state.BeforeEarned = types.StringValue(localization.Attr.BeforeEarnedDescription)
@@ -163,17 +171,14 @@ func (r *achievementLocalizationResource) Update(ctx context.Context, req resour
 		return
 	}
 
-	_, err := r.client.UpdateAchievementLocalization(ctx, appstore.AchievementLocalizationUpdate{
+	_, err := r.client.UpdateAchievementLocalization(ctx, connect.AchievementLocalizationUpdate{
 		ID:                      plan.ID.ValueString(),
 		Name:                    plan.Name.ValueString(),
 		BeforeEarnedDescription: plan.BeforeEarned.ValueString(),
 		AfterEarnedDescription:  plan.AfterEarned.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to update achievement localization",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Failed to update achievement localization", err, achievementLocalizationAttributes)
 		return
 	}
 
@@ -189,11 +194,15 @@ func (r *achievementLocalizationResource) Delete(ctx context.Context, req resour
 	}
 
 	err := r.client.DeleteAchievementLocalizationByID(ctx, state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to aelete achievement localization",
-			err.Error(),
-		)
+	if err != nil && !connect.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Failed to delete achievement localization", err, achievementLocalizationAttributes)
 		return
 	}
 }
+
+// ImportState accepts either the localization identifier or "<achievement id>/<locale>".
+func (r *achievementLocalizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importLocalizationState(ctx, req, resp, r.client.ListAchievementLocalizations, func(l connect.AchievementLocalization) string {
+		return l.Locale
+	})
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAchievementLocalizationResource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementLocalizationConfig(gameCenterID, "First Win"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_achievement_localization.test", &id),
+					resource.TestCheckResourceAttrPair("appstore_achievement_localization.test", "achievement_id", "appstore_achievement.test", "id"),
+					resource.TestCheckResourceAttr("appstore_achievement_localization.test", "locale", "en-US"),
+					resource.TestCheckResourceAttr("appstore_achievement_localization.test", "name", "First Win"),
+					resource.TestCheckResourceAttr("appstore_achievement_localization.test", "before_earned_description", "Win a match."),
+					resource.TestCheckResourceAttr("appstore_achievement_localization.test", "after_earned_description", "You won a match."),
+				),
+			},
+			{
+				ResourceName:      "appstore_achievement_localization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName: "appstore_achievement_localization.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["appstore_achievement.test"].Primary.ID + "/en-US", nil
+				},
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementLocalizationConfig(gameCenterID, "First Victory"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_achievement_localization.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_achievement_localization.test", "name", "First Victory"),
+			},
+			{
+				PreConfig: func() {
+					srv.Update("gameCenterAchievementLocalizations", id, map[string]any{"name": "Changed"})
+				},
+				Config: testAccProviderConfig(srv) + testAccAchievementLocalizationConfig(gameCenterID, "First Victory"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_achievement_localization.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_achievement_localization.test", "name", "First Victory"),
+			},
+			{
+				PreConfig: func() {
+					srv.Remove("gameCenterAchievementLocalizations", id)
+				},
+				Config: testAccProviderConfig(srv) + testAccAchievementLocalizationConfig(gameCenterID, "First Victory"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_achievement_localization.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccAchievementLocalizationConfig(gameCenterID, name string) string {
+	return testAccAchievementConfig(gameCenterID, "First Win", 10) + fmt.Sprintf(`
+resource "appstore_achievement_localization" "test" {
+  achievement_id            = appstore_achievement.test.id
+  locale                    = "en-US"
+  name                      = %q
+  before_earned_description = "Win a match."
+  after_earned_description  = "You won a match."
+}
+`, name)
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &achievementOrderResource{}
+	_ resource.ResourceWithConfigure   = &achievementOrderResource{}
+	_ resource.ResourceWithImportState = &achievementOrderResource{}
+)
+
+type achievementOrderResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	GameCenterID   types.String   `tfsdk:"game_center_id"`
+	AchievementIDs []types.String `tfsdk:"achievement_ids"`
+}
+
+type achievementOrderResource struct {
+	client *connect.Client
+}
+
+func NewAchievementOrderResource() resource.Resource {
+	return &achievementOrderResource{}
+}
+
+func (r *achievementOrderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_achievement_order"
+}
+
+func (r *achievementOrderResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*connect.Client)
+}
+
+func (r *achievementOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the order in which the achievements of a game center appear to players. " +
+			"Achievements of the game center that are not listed, such as archived ones or ones being removed, " +
+			"are kept after the listed achievements in their current order.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the achievement order, same as the game center identifier.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"game_center_id": schema.StringAttribute{
+				Description: "Identifier of the game center. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"achievement_ids": schema.ListAttribute{
+				Description: "Identifiers of the achievements of the game center, in the order they appear to players before the unlisted achievements.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *achievementOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	state := achievementOrderResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.replaceOrder(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = state.GameCenterID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *achievementOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := achievementOrderResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, err := r.client.GetGameCenterAchievementOrder(ctx, state.GameCenterID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Game center not found, removing its achievement order from state", map[string]interface{}{"id": state.GameCenterID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read achievement order", err, nil)
+		return
+	}
+
+	// Only the listed achievements are compared, the unlisted ones follow them in any order. Import takes the whole order.
+	if state.AchievementIDs != nil {
+		listed := stringValues(state.AchievementIDs)
+		ids = slices.DeleteFunc(ids, func(id string) bool {
+			return !slices.Contains(listed, id)
+		})
+	}
+	state.AchievementIDs = stringsValue(ids)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *achievementOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := achievementOrderResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.replaceOrder(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the order from state, the achievements keep their current order.
+func (r *achievementOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := achievementOrderResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Achievement order removed from state, the achievements keep their order", map[string]interface{}{"id": state.ID.ValueString()})
+}
+
+// ImportState accepts the game center identifier.
+func (r *achievementOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("game_center_id"), req.ID)...)
+}
+
+// replaceOrder checks that the planned order lists only achievements of the game center, so that achievements of
+// another game center are reported by identifier rather than as a generic relationship error, and replaces it with
+// the unlisted achievements appended in their current order, as App Store Connect requires every achievement.
+func (r *achievementOrderResource) replaceOrder(ctx context.Context, model achievementOrderResourceModel, diags *diag.Diagnostics) {
+	gameCenterID := model.GameCenterID.ValueString()
+	planned := stringValues(model.AchievementIDs)
+
+	current, err := r.client.GetGameCenterAchievementOrder(ctx, gameCenterID)
+	if err != nil {
+		addClientError(diags, "Failed to read achievement order", err, nil)
+		return
+	}
+
+	for i, id := range planned {
+		if !slices.Contains(current, id) {
+			diags.AddAttributeError(path.Root("achievement_ids").AtListIndex(i), "Achievement Not In Game Center",
+				fmt.Sprintf("Achievement %q does not belong to the game center %q.", id, gameCenterID))
+		}
+	}
+
+	if diags.HasError() {
+		return
+	}
+
+	order := slices.Clone(planned)
+	for _, id := range current {
+		if !slices.Contains(planned, id) {
+			order = append(order, id)
+		}
+	}
+
+	if err := r.client.ReplaceGameCenterAchievementOrder(ctx, gameCenterID, order); err != nil {
+		addClientError(diags, "Failed to update achievement order", err, nil)
+	}
+}
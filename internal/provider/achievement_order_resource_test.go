@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/fakeasc"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAchievementOrderResource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+	_, otherGameCenterID := srv.AddApp("Other App", "com.example.other", "OTHERAPP")
+
+	ids := []string{}
+	for _, name := range []string{"first", "second", "third"} {
+		ids = append(ids, srv.Add("gameCenterAchievements", map[string]any{
+			"referenceName":    name,
+			"vendorIdentifier": "com.example.test." + name,
+			"points":           10,
+		}, map[string]string{"gameCenterDetail": gameCenterID}))
+	}
+	otherID := srv.Add("gameCenterAchievements", map[string]any{
+		"referenceName":    "other",
+		"vendorIdentifier": "com.example.other.first",
+		"points":           10,
+	}, map[string]string{"gameCenterDetail": otherGameCenterID})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(srv) + testAccAchievementOrderConfig(gameCenterID, ids[2], ids[0], otherID, ids[1]),
+				ExpectError: regexp.MustCompile(`(?s)Achievement Not In Game Center.*` + otherID),
+			},
+			{
+				// Unlisted achievements follow the listed ones.
+				Config: testAccProviderConfig(srv) + testAccAchievementOrderConfig(gameCenterID, ids[2], ids[0]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appstore_achievement_order.test", "achievement_ids.#", "2"),
+					testAccCheckAchievementOrder(srv, gameCenterID, ids[2], ids[0], ids[1]),
+				),
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementOrderConfig(gameCenterID, ids[2], ids[0], ids[1]),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appstore_achievement_order.test", "id", gameCenterID),
+					resource.TestCheckResourceAttr("appstore_achievement_order.test", "achievement_ids.#", "3"),
+					resource.TestCheckResourceAttr("appstore_achievement_order.test", "achievement_ids.0", ids[2]),
+					testAccCheckAchievementOrder(srv, gameCenterID, ids[2], ids[0], ids[1]),
+				),
+			},
+			{
+				ResourceName:      "appstore_achievement_order.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Reordering outside of Terraform is reverted.
+				PreConfig: func() {
+					srv.SetRelationship("gameCenterDetails", gameCenterID, "gameCenterAchievements", ids)
+				},
+				Config: testAccProviderConfig(srv) + testAccAchievementOrderConfig(gameCenterID, ids[2], ids[0], ids[1]),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_achievement_order.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckAchievementOrder(srv, gameCenterID, ids[2], ids[0], ids[1]),
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementOrderConfig(gameCenterID, ids[1], ids[2], ids[0]),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_achievement_order.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckAchievementOrder(srv, gameCenterID, ids[1], ids[2], ids[0]),
+			},
+		},
+	})
+}
+
+func testAccCheckAchievementOrder(srv *fakeasc.Server, gameCenterID string, ids ...string) func(*terraform.State) error {
+	return func(_ *terraform.State) error {
+		gameCenter, ok := srv.Get("gameCenterDetails", gameCenterID)
+		if !ok {
+			return fmt.Errorf("game center %s not found", gameCenterID)
+		}
+
+		got := gameCenter.Relationships["gameCenterAchievements"]
+		if strings.Join(got, ",") != strings.Join(ids, ",") {
+			return fmt.Errorf("game center %s has achievement order %v, want %v", gameCenterID, got, ids)
+		}
+
+		return nil
+	}
+}
+
+func testAccAchievementOrderConfig(gameCenterID string, ids ...string) string {
+	quoted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		quoted = append(quoted, fmt.Sprintf("%q", id))
+	}
+
+	return fmt.Sprintf(`
+resource "appstore_achievement_order" "test" {
+  game_center_id  = %q
+  achievement_ids = [%s]
+}
+`, gameCenterID, strings.Join(quoted, ", "))
+}
+
+func TestAccAchievementOrderResource_removeAchievement(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	achievements := func(names ...string) string {
+		config := ""
+		for _, name := range names {
+			config += fmt.Sprintf(`
+resource "appstore_achievement" %[1]q {
+  game_center_id     = %[2]q
+  reference_name     = %[1]q
+  vendor_id          = "com.example.test.%[1]s"
+  points             = 10
+  repeatable         = false
+  show_before_earned = true
+  deletion_policy    = "archive"
+}
+`, name, gameCenterID)
+		}
+		return config
+	}
+	order := func(names ...string) string {
+		ids := make([]string, 0, len(names))
+		for _, name := range names {
+			ids = append(ids, "appstore_achievement."+name+".id")
+		}
+		return fmt.Sprintf(`
+resource "appstore_achievement_order" "test" {
+  game_center_id  = %q
+  achievement_ids = [%s]
+}
+`, gameCenterID, strings.Join(ids, ", "))
+	}
+
+	var first, second, third string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + achievements("first", "second", "third") + order("third", "first", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_achievement.first", &first),
+					testAccResourceID("appstore_achievement.second", &second),
+					testAccResourceID("appstore_achievement.third", &third),
+					func(s *terraform.State) error {
+						return testAccCheckAchievementOrder(srv, gameCenterID, third, first, second)(s)
+					},
+				),
+			},
+			{
+				// The removed achievement is archived and kept after the listed ones in a single apply.
+				Config: testAccProviderConfig(srv) + achievements("first", "third") + order("first", "third"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appstore_achievement_order.test", "achievement_ids.#", "2"),
+					func(s *terraform.State) error {
+						return testAccCheckAchievementOrder(srv, gameCenterID, first, third, second)(s)
+					},
+					testAccCheckAchievementArchived(srv, &second, true),
+				),
+			},
+		},
+	})
+}
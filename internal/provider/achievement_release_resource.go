@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &achievementReleaseResource{}
+	_ resource.ResourceWithConfigure   = &achievementReleaseResource{}
+	_ resource.ResourceWithImportState = &achievementReleaseResource{}
+)
+
+type achievementReleaseResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	AchievementID types.String `tfsdk:"achievement_id"`
+	GameCenterID  types.String `tfsdk:"game_center_id"`
+	Live          types.Bool   `tfsdk:"live"`
+}
+
+// achievementReleaseAttributes maps App Store Connect attributes and relationships of the release to the schema attributes.
+var achievementReleaseAttributes = map[string]string{
+	"gameCenterAchievement": "achievement_id",
+	"gameCenterDetail":      "game_center_id",
+	"live":                  "live",
+}
+
+type achievementReleaseResource struct {
+	client *connect.Client
+}
+
+func NewAchievementReleaseResource() resource.Resource {
+	return &achievementReleaseResource{}
+}
+
+func (r *achievementReleaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_achievement_release"
+}
+
+func (r *achievementReleaseResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*connect.Client)
+}
+
+func (r *achievementReleaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages game center achievement release, which publishes the achievement to the game center of an app. " +
+			"The release goes live with the next approved version of the app.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the achievement release.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"achievement_id": schema.StringAttribute{
+				Description: "Identifier of the achievement to release. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"game_center_id": schema.StringAttribute{
+				Description: "Identifier of the game center to release the achievement to. " +
+					"For an achievement of a game center group, one of the game centers in the group. Resource will be re-created if this value is changed.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"live": schema.BoolAttribute{
+				Description: "An indication of whether the release is live, that is, the app version it shipped with was approved.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *achievementReleaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := achievementReleaseResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	release, err := r.client.CreateAchievementRelease(ctx, plan.AchievementID.ValueString(), plan.GameCenterID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to create achievement release", err, achievementReleaseAttributes)
+		return
+	}
+
+	plan.ID = types.StringValue(release.ID)
+	plan.Live = types.BoolValue(release.Attr.Live)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *achievementReleaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := achievementReleaseResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	release, err := r.client.GetAchievementReleaseByID(ctx, state.ID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Achievement release not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read achievement release", err, achievementReleaseAttributes)
+		return
+	}
+
+	state.AchievementID = types.StringValue(release.RelatedID("gameCenterAchievement"))
+	state.GameCenterID = types.StringValue(release.RelatedID("gameCenterDetail"))
+	state.Live = types.BoolValue(release.Attr.Live)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with a change, as every configurable attribute requires replacement.
+func (r *achievementReleaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := achievementReleaseResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *achievementReleaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := achievementReleaseResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteAchievementReleaseByID(ctx, state.ID.ValueString())
+	if err != nil && !connect.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Failed to delete achievement release", err, achievementReleaseAttributes)
+		return
+	}
+}
+
+func (r *achievementReleaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
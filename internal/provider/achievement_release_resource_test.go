@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccAchievementReleaseResource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementReleaseConfig(gameCenterID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_achievement_release.test", &id),
+					resource.TestCheckResourceAttrPair("appstore_achievement_release.test", "achievement_id", "appstore_achievement.test", "id"),
+					resource.TestCheckResourceAttr("appstore_achievement_release.test", "game_center_id", gameCenterID),
+					resource.TestCheckResourceAttr("appstore_achievement_release.test", "live", "false"),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "live", "false"),
+				),
+			},
+			{
+				ResourceName:      "appstore_achievement_release.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The release goes live once the app version is approved.
+				PreConfig: func() {
+					srv.Update("gameCenterAchievementReleases", id, map[string]any{"live": true})
+				},
+				Config: testAccProviderConfig(srv) + testAccAchievementReleaseConfig(gameCenterID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appstore_achievement_release.test", "live", "true"),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "live", "true"),
+				),
+			},
+			{
+				// Releases deleted outside of Terraform are re-created.
+				PreConfig: func() {
+					srv.Remove("gameCenterAchievementReleases", id)
+				},
+				Config: testAccProviderConfig(srv) + testAccAchievementReleaseConfig(gameCenterID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_achievement_release.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_achievement_release.test", "live", "false"),
+			},
+		},
+	})
+}
+
+func testAccAchievementReleaseConfig(gameCenterID string) string {
+	return testAccAchievementConfig(gameCenterID, "First Win", 10) + fmt.Sprintf(`
+resource "appstore_achievement_release" "test" {
+  achievement_id = appstore_achievement.test.id
+  game_center_id = %q
+}
+`, gameCenterID)
+}
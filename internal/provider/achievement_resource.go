@@ -2,32 +2,85 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
 
-	"github.com/alexprogrammr/appstore-go"
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource              = &achievementResource{}
-	_ resource.ResourceWithConfigure = &achievementResource{}
+	_ resource.Resource                     = &achievementResource{}
+	_ resource.ResourceWithConfigure        = &achievementResource{}
+	_ resource.ResourceWithImportState      = &achievementResource{}
+	_ resource.ResourceWithConfigValidators = &achievementResource{}
+	_ resource.ResourceWithModifyPlan       = &achievementResource{}
 )
 
+// Game center limits on achievements enforced by App Store Connect.
+const (
+	maxAchievementPoints           = 100
+	maxGameCenterAchievementPoints = 1000
+	maxLiveAchievements            = 100
+	maxAchievementReferenceName    = 64
+	maxAchievementVendorID         = 100
+)
+
+// achievementVendorIDPattern matches the characters allowed in the vendor identifier of an achievement.
+var achievementVendorIDPattern = regexp.MustCompile(`^[A-Za-z0-9_.]+$`)
+
 type achievementResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	GameCenterID     types.String `tfsdk:"game_center_id"`
-	ReferenceName    types.String `tfsdk:"reference_name"`
-	VendorID         types.String `tfsdk:"vendor_id"`
-	Points           types.Int64  `tfsdk:"points"`
-	Repeatable       types.Bool   `tfsdk:"repeatable"`
-	ShowBeforeEarned types.Bool   `tfsdk:"show_before_earned"`
+	ID                types.String `tfsdk:"id"`
+	GameCenterID      types.String `tfsdk:"game_center_id"`
+	GameCenterGroupID types.String `tfsdk:"game_center_group_id"`
+	ReferenceName     types.String `tfsdk:"reference_name"`
+	VendorID          types.String `tfsdk:"vendor_id"`
+	Points            types.Int64  `tfsdk:"points"`
+	Repeatable        types.Bool   `tfsdk:"repeatable"`
+	ShowBeforeEarned  types.Bool   `tfsdk:"show_before_earned"`
+	Archived          types.Bool   `tfsdk:"archived"`
+	DeletionPolicy    types.String `tfsdk:"deletion_policy"`
+	Live              types.Bool   `tfsdk:"live"`
 }
 
+// achievementAttributes maps App Store Connect attributes and relationships of the achievement to the schema attributes.
+var achievementAttributes = map[string]string{
+	"gameCenterDetail": "game_center_id",
+	"gameCenterGroup":  "game_center_group_id",
+	"referenceName":    "reference_name",
+	"vendorIdentifier": "vendor_id",
+	"points":           "points",
+	"repeatable":       "repeatable",
+	"showBeforeEarned": "show_before_earned",
+	"archived":         "archived",
+}
+
+// Deletion policies of the achievement, live achievements cannot be deleted and are archived instead.
+const (
+	achievementDeletionPolicyDelete  = "delete"
+	achievementDeletionPolicyArchive = "archive"
+	achievementDeletionPolicyAbandon = "abandon"
+)
+
+// achievementReplacedKey is the private state key of the identifier of the achievement being replaced.
+const achievementReplacedKey = "replaced_id"
+
 type achievementResource struct {
-	client *appstore.Client
+	client *connect.Client
 }
 
 func NewAchievementResource() resource.Resource {
@@ -43,7 +96,7 @@ func (d *achievementResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	d.client = req.ProviderData.(*appstore.Client)
+	d.client = req.ProviderData.(*connect.Client)
 }
 
 func (r *achievementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -58,26 +111,47 @@ func (r *achievementResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"game_center_id": schema.StringAttribute{
-				Description: "Identifier of the game center to associate the achievement with. Resource will be re-created if this value is changed.",
-				Required:    true,
+				Description: "Identifier of the game center to associate the achievement with. Conflicts with game_center_group_id. " +
+					"Resource will be re-created if this value is changed.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"game_center_group_id": schema.StringAttribute{
+				Description: "Identifier of the game center group to associate the achievement with, sharing it among the apps of the group. " +
+					"Conflicts with game_center_id. Resource will be re-created if this value is changed.",
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"reference_name": schema.StringAttribute{
-				Description: "An internal name of the achievement.",
+				Description: "An internal name of the achievement, up to 64 characters.",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, maxAchievementReferenceName),
+				},
 			},
 			"vendor_id": schema.StringAttribute{
-				Description: "A chosen alphanumeric identifier of the achievement. Resource will be re-created if this value is changed.",
-				Required:    true,
+				Description: "A chosen identifier of the achievement, up to 100 alphanumeric characters, underscores and periods. " +
+					"Resource will be re-created if this value is changed.",
+				Required: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, maxAchievementVendorID),
+					stringvalidator.RegexMatches(achievementVendorIDPattern, "must contain only alphanumeric characters, underscores and periods"),
+				},
 			},
 			"points": schema.Int64Attribute{
-				Description: "The points that each achievement is worth.",
-				Required:    true,
+				Description: "The points that each achievement is worth, up to 100. " +
+					"The achievements of a game center or a game center group are worth up to 1000 points in total.",
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, maxAchievementPoints),
+				},
 			},
 			"repeatable": schema.BoolAttribute{
 				Description: "An indication of whether the player can earn the achievement multiple times.",
@@ -87,37 +161,150 @@ func (r *achievementResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "An indication of whether the achievement is visible to the player before it is earned.",
 				Required:    true,
 			},
+			"archived": schema.BoolAttribute{
+				Description: "An indication of whether the achievement is archived. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"deletion_policy": schema.StringAttribute{
+				Description: "What happens to the achievement when it is removed from the configuration: " +
+					"`delete` deletes it, or archives it if it has been live, as live achievements cannot be deleted; " +
+					"`archive` archives it; `abandon` only removes it from the state. Defaults to `delete`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(achievementDeletionPolicyDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(achievementDeletionPolicyDelete, achievementDeletionPolicyArchive, achievementDeletionPolicyAbandon),
+				},
+			},
+			"live": schema.BoolAttribute{
+				Description: "An indication of whether any release of the achievement is live. See appstore_achievement_release resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
-func (r *achievementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	state := achievementResourceModel{}
+func (r *achievementResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return ownerConfigValidators()
+}
+
+// ModifyPlan checks the planned points against the points of the other achievements of the game center, so that
+// exceeding the game center limits fails before apply. Achievements planned in the same run are not known yet.
+func (r *achievementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	plan := achievementResourceModel{}
 
-	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	gameCenterId := state.GameCenterID.ValueString()
-	if gameCenterId == "" {
-		resp.Diagnostics.AddError(
-			"Missing required attribute",
-			"Attribute 'game_center_id' is required to create an achievement.",
-		)
+	if plan.Points.IsUnknown() || plan.Archived.IsUnknown() || plan.GameCenterID.IsUnknown() || plan.GameCenterGroupID.IsUnknown() {
 		return
 	}
 
-	gameCenter, err := r.client.GetGameCenterByID(ctx, gameCenterId)
+	// The current achievement is left out of the totals, it is counted with the planned values. Terraform plans
+	// a replacement once more as a create without the state, so the identifier of the replaced achievement is
+	// passed on to that plan in the private state.
+	currentID := ""
+
+	if !req.State.Raw.IsNull() {
+		state := achievementResourceModel{}
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		currentID = state.ID.ValueString()
+
+		if !plan.VendorID.Equal(state.VendorID) ||
+			!plan.GameCenterID.Equal(state.GameCenterID) || !plan.GameCenterGroupID.Equal(state.GameCenterGroupID) {
+			value, err := json.Marshal(currentID)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to plan achievement replacement", err.Error())
+				return
+			}
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, achievementReplacedKey, value)...)
+		}
+
+		// Avoid listing the achievements on every refresh, the totals only change with the points, the archived
+		// state or the owner of the achievement.
+		if plan.Points.Equal(state.Points) && plan.Archived.Equal(state.Archived) &&
+			plan.GameCenterID.Equal(state.GameCenterID) && plan.GameCenterGroupID.Equal(state.GameCenterGroupID) {
+			return
+		}
+	} else {
+		value, diags := req.Private.GetKey(ctx, achievementReplacedKey)
+		resp.Diagnostics.Append(diags...)
+		if len(value) > 0 {
+			if err := json.Unmarshal(value, &currentID); err != nil {
+				resp.Diagnostics.AddError("Failed to plan achievement replacement", err.Error())
+				return
+			}
+		}
+	}
+
+	owner := connect.Owner{GameCenterID: plan.GameCenterID.ValueString(), GroupID: plan.GameCenterGroupID.ValueString()}
+
+	achievements, err := r.client.ListAchievements(ctx, owner)
+	if connect.IsNotFound(err) {
+		// The owner is checked again on create.
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to read game center",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Failed to read achievements", err, achievementAttributes)
 		return
 	}
 
-	achievement := appstore.Achievement{
+	// Archived achievements do not count towards the limits.
+	points, count := 0, 0
+	for _, achievement := range achievements {
+		if achievement.ID == currentID || achievement.Attr.Archived {
+			continue
+		}
+		points += achievement.Attr.Points
+		count++
+	}
+	if !plan.Archived.ValueBool() {
+		points += int(plan.Points.ValueInt64())
+		count++
+	}
+
+	if points > maxGameCenterAchievementPoints {
+		resp.Diagnostics.AddAttributeError(path.Root("points"), "Game Center Points Limit Exceeded",
+			fmt.Sprintf("The achievements of the game center would be worth %d points in total, which exceeds the limit of %d points. "+
+				"Lower the points or archive other achievements.", points, maxGameCenterAchievementPoints))
+	}
+	if count > maxLiveAchievements {
+		resp.Diagnostics.AddWarning("Game Center Achievements Limit Exceeded",
+			fmt.Sprintf("The game center would have %d active achievements, at most %d of them can be live per app.", count, maxLiveAchievements))
+	}
+}
+
+func (r *achievementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	state := achievementResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner, ok := readOwner(ctx, r.client, state.GameCenterID, state.GameCenterGroupID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	achievement := connect.Achievement{
 		ReferenceName:    state.ReferenceName.ValueString(),
 		VendorIdentifier: state.VendorID.ValueString(),
 		Points:           int(state.Points.ValueInt64()),
@@ -125,16 +312,31 @@ func (r *achievementResource) Create(ctx context.Context, req resource.CreateReq
 		ShowBeforeEarned: state.ShowBeforeEarned.ValueBool(),
 	}
 
-	response, err := r.client.CreateAchievement(ctx, gameCenter, &achievement)
+	response, err := r.client.CreateAchievement(ctx, owner, &achievement)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to create achievement",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Failed to create achievement", err, achievementAttributes)
 		return
 	}
 
 	state.ID = types.StringValue(response.ID)
+	state.Live = types.BoolValue(false)
+
+	// Achievements are always created active, archive it with a follow-up update if requested. The created
+	// achievement is saved first, so that it is not lost from state if archiving fails.
+	if state.Archived.ValueBool() {
+		state.Archived = types.BoolValue(false)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Archived = types.BoolValue(true)
+		if _, err := r.client.UpdateAchievement(ctx, achievementUpdate(state)); err != nil {
+			addClientError(&resp.Diagnostics, "Failed to archive achievement", err, achievementAttributes)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -146,20 +348,31 @@ func (r *achievementResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	achievement, err := r.client.GetAchievementByID(ctx, state.ID.ValueString())
+	// The releases are included in the response to tell whether the achievement is live without another request.
+	achievement, err := r.client.GetAchievementDetails(ctx, state.ID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Achievement not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to read achievement",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Failed to read achievement", err, achievementAttributes)
 		return
 	}
 
+	state.GameCenterID, state.GameCenterGroupID = ownerValues(&achievement.Resource)
 	state.ReferenceName = types.StringValue(achievement.Attr.ReferenceName)
 	state.VendorID = types.StringValue(achievement.Attr.VendorIdentifier)
 	state.Points = types.Int64Value(int64(achievement.Attr.Points))
 	state.Repeatable = types.BoolValue(achievement.Attr.Repeatable)
 	state.ShowBeforeEarned = types.BoolValue(achievement.Attr.ShowBeforeEarned)
+	state.Archived = types.BoolValue(achievement.Attr.Archived)
+	state.Live = types.BoolValue(achievementLive(achievement))
+
+	// The deletion policy is not stored remotely, imported achievements get the default.
+	if state.DeletionPolicy.IsNull() {
+		state.DeletionPolicy = types.StringValue(achievementDeletionPolicyDelete)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -172,18 +385,9 @@ func (r *achievementResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	_, err := r.client.UpdateAchievement(ctx, appstore.AchievementUpdate{
-		ID:               plan.ID.ValueString(),
-		ReferenceName:    plan.ReferenceName.ValueString(),
-		Points:           int(plan.Points.ValueInt64()),
-		Repeatable:       plan.Repeatable.ValueBool(),
-		ShowBeforeEarned: plan.ShowBeforeEarned.ValueBool(),
-	})
+	_, err := r.client.UpdateAchievement(ctx, achievementUpdate(plan))
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to update achievement",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Failed to update achievement", err, achievementAttributes)
 		return
 	}
 
@@ -198,12 +402,53 @@ func (r *achievementResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := r.client.DeleteAchievementByID(ctx, state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to delete achievement",
-			err.Error(),
-		)
+	policy := state.DeletionPolicy.ValueString()
+	if policy == achievementDeletionPolicyAbandon {
+		tflog.Warn(ctx, "Achievement abandoned, removing it from state only", map[string]interface{}{"id": state.ID.ValueString()})
 		return
 	}
+
+	// A release may have gone live since the last refresh, so the deletion is always attempted and App Store Connect
+	// decides whether the achievement has been live.
+	if policy == achievementDeletionPolicyDelete {
+		err := r.client.DeleteAchievementByID(ctx, state.ID.ValueString())
+		switch {
+		case err == nil, connect.IsNotFound(err):
+			return
+		case !connect.IsConflict(err):
+			addClientError(&resp.Diagnostics, "Failed to delete achievement", err, achievementAttributes)
+			return
+		}
+
+		tflog.Warn(ctx, "Achievement has been live and cannot be deleted, archiving it instead", map[string]interface{}{"id": state.ID.ValueString()})
+	}
+
+	state.Archived = types.BoolValue(true)
+	_, err := r.client.UpdateAchievement(ctx, achievementUpdate(state))
+	if err != nil && !connect.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Failed to archive achievement", err, achievementAttributes)
+		return
+	}
+}
+
+// achievementLive reports whether any release of the achievement is live.
+func achievementLive(achievement *connect.AchievementDetails) bool {
+	return slices.ContainsFunc(achievement.Releases, func(release connect.Resource[connect.AchievementRelease]) bool {
+		return release.Attr.Live
+	})
+}
+
+func achievementUpdate(model achievementResourceModel) connect.AchievementUpdate {
+	return connect.AchievementUpdate{
+		ID:               model.ID.ValueString(),
+		ReferenceName:    model.ReferenceName.ValueString(),
+		Points:           int(model.Points.ValueInt64()),
+		Repeatable:       model.Repeatable.ValueBool(),
+		ShowBeforeEarned: model.ShowBeforeEarned.ValueBool(),
+		Archived:         model.Archived.ValueBool(),
+	}
+}
+
+func (r *achievementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
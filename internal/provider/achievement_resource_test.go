@@ -0,0 +1,415 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/fakeasc"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAchievementResource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, "First Win", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_achievement.test", &id),
+					resource.TestCheckResourceAttrSet("appstore_achievement.test", "id"),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "game_center_id", gameCenterID),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "reference_name", "First Win"),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "vendor_id", "com.example.test.first_win"),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "points", "10"),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "repeatable", "false"),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "show_before_earned", "true"),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "archived", "false"),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "deletion_policy", "delete"),
+				),
+			},
+			{
+				ResourceName:      "appstore_achievement.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, "First Victory", 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_achievement.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appstore_achievement.test", "reference_name", "First Victory"),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "points", "20"),
+				),
+			},
+			{
+				// Changes made outside of Terraform are reverted.
+				PreConfig: func() {
+					srv.Update("gameCenterAchievements", id, map[string]any{"points": 50})
+				},
+				Config: testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, "First Victory", 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_achievement.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_achievement.test", "points", "20"),
+			},
+			{
+				// Achievements deleted outside of Terraform are re-created.
+				PreConfig: func() {
+					srv.Remove("gameCenterAchievements", id)
+				},
+				Config: testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, "First Victory", 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_achievement.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccAchievementResource_duplicateVendorID(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	srv.Add("gameCenterAchievements", map[string]any{
+		"referenceName":    "Existing",
+		"vendorIdentifier": "com.example.test.first_win",
+		"points":           5,
+	}, map[string]string{"gameCenterDetail": gameCenterID})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, "First Win", 10),
+				ExpectError: regexp.MustCompile(`ENTITY_ERROR\.ATTRIBUTE\.INVALID\.DUPLICATE`),
+			},
+		},
+	})
+}
+
+func TestAccAchievementResource_archived(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementExtraConfig(gameCenterID, `archived = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_achievement.test", &id),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "archived", "true"),
+					testAccCheckAchievementArchived(srv, &id, true),
+				),
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, "First Win", 10),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_achievement.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appstore_achievement.test", "archived", "false"),
+					testAccCheckAchievementArchived(srv, &id, false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAchievementResource_archiveFailure(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	config := testAccProviderConfig(srv) + testAccAchievementExtraConfig(gameCenterID, `archived = true`)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					srv.InjectFault(fakeasc.Fault{Method: http.MethodPatch, Path: "/v1/gameCenterAchievements/", Status: http.StatusConflict, Times: 1})
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Failed to archive achievement`),
+			},
+			{
+				// The achievement created before the failure is kept in state, it is not created again with a
+				// duplicate vendor identifier.
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_achievement.test", &id),
+					resource.TestCheckResourceAttr("appstore_achievement.test", "archived", "true"),
+					testAccCheckAchievementArchived(srv, &id, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAchievementResource_deletionPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   string
+		live     bool
+		rejected bool
+		exists   bool
+		archived bool
+	}{
+		{name: "delete", policy: "delete", exists: false},
+		{name: "delete live", policy: "delete", live: true, exists: true, archived: true},
+		{name: "delete rejected", policy: "delete", rejected: true, exists: true, archived: true},
+		{name: "archive", policy: "archive", exists: true, archived: true},
+		{name: "abandon", policy: "abandon", exists: true, archived: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, gameCenterID := testAccServer(t)
+
+			var id string
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccProviderConfig(srv) + testAccAchievementExtraConfig(gameCenterID, fmt.Sprintf("deletion_policy = %q", tt.policy)),
+						Check:  testAccResourceID("appstore_achievement.test", &id),
+					},
+					{
+						// Live achievements cannot be deleted remotely, the rejection is also honored when the
+						// release went live after the refresh.
+						PreConfig: func() {
+							if tt.live {
+								srv.Add("gameCenterAchievementReleases", map[string]any{"live": true}, map[string]string{
+									"gameCenterAchievement": id,
+									"gameCenterDetail":      gameCenterID,
+								})
+							}
+							if tt.rejected {
+								srv.InjectFault(fakeasc.Fault{Method: http.MethodDelete, Path: "/v1/gameCenterAchievements/", Status: http.StatusConflict, Times: 1})
+							}
+						},
+						Config: testAccProviderConfig(srv),
+						Check: func(_ *terraform.State) error {
+							obj, ok := srv.Get("gameCenterAchievements", id)
+							if ok != tt.exists {
+								return fmt.Errorf("got achievement %s exists %t, want %t", id, ok, tt.exists)
+							}
+							if ok && obj.Attributes["archived"] != tt.archived {
+								return fmt.Errorf("got achievement %s archived %v, want %t", id, obj.Attributes["archived"], tt.archived)
+							}
+							return nil
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccAchievementResource_invalidAttributes(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, "First Win", 101),
+				ExpectError: regexp.MustCompile(`Attribute points value must be between 0 and 100`),
+			},
+			{
+				Config:      testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, strings.Repeat("a", 65), 10),
+				ExpectError: regexp.MustCompile(`Attribute reference_name string length must be between 1 and 64`),
+			},
+			{
+				Config: testAccProviderConfig(srv) + strings.Replace(testAccAchievementConfig(gameCenterID, "First Win", 10),
+					"com.example.test.first_win", "com.example.test.first-win", 1),
+				ExpectError: regexp.MustCompile(`Attribute vendor_id must contain only alphanumeric characters`),
+			},
+		},
+	})
+}
+
+func TestAccAchievementResource_pointsLimit(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	for i := 0; i < 9; i++ {
+		srv.Add("gameCenterAchievements", map[string]any{
+			"referenceName":    fmt.Sprintf("Existing %d", i),
+			"vendorIdentifier": fmt.Sprintf("com.example.test.existing_%d", i),
+			"points":           100,
+		}, map[string]string{"gameCenterDetail": gameCenterID})
+	}
+	archivedID := srv.Add("gameCenterAchievements", map[string]any{
+		"referenceName":    "Archived",
+		"vendorIdentifier": "com.example.test.archived",
+		"points":           100,
+		"archived":         true,
+	}, map[string]string{"gameCenterDetail": gameCenterID})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Archived achievements do not count towards the limit.
+				Config: testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, "First Win", 100),
+				Check:  resource.TestCheckResourceAttr("appstore_achievement.test", "points", "100"),
+			},
+			{
+				PreConfig: func() {
+					srv.Update("gameCenterAchievements", archivedID, map[string]any{"archived": false})
+				},
+				Config:      testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, "First Win", 90),
+				ExpectError: regexp.MustCompile(`Game Center Points Limit Exceeded`),
+			},
+			{
+				// Archiving the achievement takes its points out of the total.
+				Config: testAccProviderConfig(srv) + testAccAchievementExtraConfig(gameCenterID, `archived = true`),
+				Check:  resource.TestCheckResourceAttr("appstore_achievement.test", "archived", "true"),
+			},
+		},
+	})
+}
+
+func TestAccAchievementResource_pointsLimitReplace(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	for i := 0; i < 9; i++ {
+		srv.Add("gameCenterAchievements", map[string]any{
+			"referenceName":    fmt.Sprintf("Existing %d", i),
+			"vendorIdentifier": fmt.Sprintf("com.example.test.existing_%d", i),
+			"points":           100,
+		}, map[string]string{"gameCenterDetail": gameCenterID})
+	}
+
+	replaced := strings.Replace(testAccAchievementConfig(gameCenterID, "First Win", 100),
+		"com.example.test.first_win", "com.example.test.first_victory", 1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, "First Win", 90),
+			},
+			{
+				// The replaced achievement does not count towards the limit together with its replacement.
+				Config: testAccProviderConfig(srv) + replaced,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_achievement.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_achievement.test", "points", "100"),
+			},
+		},
+	})
+}
+
+func TestAccAchievementResource_pointsLimitOtherGameCenter(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	_, fullGameCenterID := srv.AddApp("Full App", "com.example.full", "FULLAPP")
+	for i := 0; i < 10; i++ {
+		srv.Add("gameCenterAchievements", map[string]any{
+			"referenceName":    fmt.Sprintf("Existing %d", i),
+			"vendorIdentifier": fmt.Sprintf("com.example.full.existing_%d", i),
+			"points":           100,
+		}, map[string]string{"gameCenterDetail": fullGameCenterID})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, "First Win", 10),
+				Check:  resource.TestCheckResourceAttr("appstore_achievement.test", "game_center_id", gameCenterID),
+			},
+			{
+				// Moving the achievement checks the totals of the game center it is moved to.
+				Config:      testAccProviderConfig(srv) + testAccAchievementConfig(fullGameCenterID, "First Win", 10),
+				ExpectError: regexp.MustCompile(`Game Center Points Limit Exceeded`),
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccAchievementConfig(gameCenterID, "First Win", 10),
+			},
+		},
+	})
+}
+
+func TestAccAchievementResource_group(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	config := testAccProviderConfig(srv) + testAccGameCenterGroupConfig("Shared", gameCenterID) +
+		strings.Replace(testAccAchievementConfig("", "First Win", 10),
+			`game_center_id     = ""`, `game_center_group_id = appstore_game_center_group.test.id`, 1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("appstore_achievement.test", "game_center_group_id", "appstore_game_center_group.test", "id"),
+					resource.TestCheckNoResourceAttr("appstore_achievement.test", "game_center_id"),
+				),
+			},
+			{
+				ResourceName:      "appstore_achievement.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      strings.Replace(config, "game_center_group_id", fmt.Sprintf("game_center_id = %q\n  game_center_group_id", gameCenterID), 1),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccAchievementConfig(gameCenterID, name string, points int) string {
+	return fmt.Sprintf(`
+resource "appstore_achievement" "test" {
+  game_center_id     = %q
+  reference_name     = %q
+  vendor_id          = "com.example.test.first_win"
+  points             = %d
+  repeatable         = false
+  show_before_earned = true
+}
+`, gameCenterID, name, points)
+}
+
+// testAccAchievementExtraConfig returns the achievement configuration with an extra attribute.
+func testAccAchievementExtraConfig(gameCenterID, extra string) string {
+	return strings.Replace(testAccAchievementConfig(gameCenterID, "First Win", 10),
+		"show_before_earned = true", "show_before_earned = true\n  "+extra, 1)
+}
+
+func testAccCheckAchievementArchived(srv *fakeasc.Server, id *string, want bool) func(*terraform.State) error {
+	return func(_ *terraform.State) error {
+		obj, _ := srv.Get("gameCenterAchievements", *id)
+		if obj.Attributes["archived"] != want {
+			return fmt.Errorf("got achievement %s archived %v remotely, want %t", *id, obj.Attributes["archived"], want)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &achievementsDataSource{}
+	_ datasource.DataSourceWithConfigure        = &achievementsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &achievementsDataSource{}
+)
+
+type achievementsDataSourceModel struct {
+	GameCenterID      types.String           `tfsdk:"game_center_id"`
+	GameCenterGroupID types.String           `tfsdk:"game_center_group_id"`
+	AppID             types.String           `tfsdk:"app_id"`
+	Achievements      []achievementDataModel `tfsdk:"achievements"`
+}
+
+type achievementDataModel struct {
+	ID               types.String                       `tfsdk:"id"`
+	ReferenceName    types.String                       `tfsdk:"reference_name"`
+	VendorID         types.String                       `tfsdk:"vendor_id"`
+	Points           types.Int64                        `tfsdk:"points"`
+	Repeatable       types.Bool                         `tfsdk:"repeatable"`
+	ShowBeforeEarned types.Bool                         `tfsdk:"show_before_earned"`
+	Archived         types.Bool                         `tfsdk:"archived"`
+	Live             types.Bool                         `tfsdk:"live"`
+	Localizations    []achievementLocalizationDataModel `tfsdk:"localizations"`
+}
+
+type achievementLocalizationDataModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Locale                  types.String `tfsdk:"locale"`
+	Name                    types.String `tfsdk:"name"`
+	BeforeEarnedDescription types.String `tfsdk:"before_earned_description"`
+	AfterEarnedDescription  types.String `tfsdk:"after_earned_description"`
+}
+
+type achievementsDataSource struct {
+	client *connect.Client
+}
+
+func NewAchievementsDataSource() datasource.DataSource {
+	return &achievementsDataSource{}
+}
+
+func (d *achievementsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_achievements"
+}
+
+func (d *achievementsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*connect.Client)
+}
+
+func (d *achievementsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of game center achievements from the App Store Connect, including the archived ones.",
+		Attributes: map[string]schema.Attribute{
+			"game_center_id": schema.StringAttribute{
+				Description: "Identifier of the game center to fetch achievements for. Conflicts with game_center_group_id and app_id.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"game_center_group_id": schema.StringAttribute{
+				Description: "Identifier of the game center group to fetch achievements shared among its apps for. " +
+					"Conflicts with game_center_id and app_id.",
+				Optional: true,
+			},
+			"app_id": schema.StringAttribute{
+				Description: "Identifier of the app to fetch achievements of its game center for. Conflicts with game_center_id and game_center_group_id.",
+				Optional:    true,
+			},
+			"achievements": schema.ListNestedAttribute{
+				Description: "List of achievements",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the achievement.",
+							Computed:    true,
+						},
+						"reference_name": schema.StringAttribute{
+							Description: "An internal name of the achievement.",
+							Computed:    true,
+						},
+						"vendor_id": schema.StringAttribute{
+							Description: "A chosen identifier of the achievement.",
+							Computed:    true,
+						},
+						"points": schema.Int64Attribute{
+							Description: "The points that the achievement is worth.",
+							Computed:    true,
+						},
+						"repeatable": schema.BoolAttribute{
+							Description: "An indication of whether the player can earn the achievement multiple times.",
+							Computed:    true,
+						},
+						"show_before_earned": schema.BoolAttribute{
+							Description: "An indication of whether the achievement is visible to the player before it is earned.",
+							Computed:    true,
+						},
+						"archived": schema.BoolAttribute{
+							Description: "An indication of whether the achievement is archived.",
+							Computed:    true,
+						},
+						"live": schema.BoolAttribute{
+							Description: "An indication of whether any release of the achievement is live.",
+							Computed:    true,
+						},
+						"localizations": schema.ListNestedAttribute{
+							Description: "List of localizations of the achievement",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Identifier of the localization.",
+										Computed:    true,
+									},
+									"locale": schema.StringAttribute{
+										Description: "Locale of the localization, for example, en-US.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "Localized name of the achievement.",
+										Computed:    true,
+									},
+									"before_earned_description": schema.StringAttribute{
+										Description: "Localized description of the achievement before it is earned.",
+										Computed:    true,
+									},
+									"after_earned_description": schema.StringAttribute{
+										Description: "Localized description of the achievement after it is earned.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *achievementsDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("game_center_id"),
+			path.MatchRoot("game_center_group_id"),
+			path.MatchRoot("app_id"),
+		),
+	}
+}
+
+func (d *achievementsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	state := achievementsDataSourceModel{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.AppID.IsNull() {
+		gameCenter, err := d.client.GetGameCenter(ctx, state.AppID.ValueString())
+		if connect.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Game center not found",
+				fmt.Sprintf("Game Center is not enabled for the app with identifier %q.", state.AppID.ValueString()),
+			)
+			return
+		}
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Failed to read game center", err, nil)
+			return
+		}
+
+		state.GameCenterID = types.StringValue(gameCenter.ID)
+	}
+
+	owner := connect.Owner{GameCenterID: state.GameCenterID.ValueString(), GroupID: state.GameCenterGroupID.ValueString()}
+
+	achievements, err := d.client.ListAchievementDetails(ctx, owner)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read achievements", err, nil)
+		return
+	}
+
+	state.Achievements = []achievementDataModel{}
+	for i := range achievements {
+		state.Achievements = append(state.Achievements, readAchievementData(&achievements[i]))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readAchievementData returns the achievement with its localizations and its release state.
+func readAchievementData(achievement *connect.AchievementDetails) achievementDataModel {
+	model := achievementDataModel{
+		ID:               types.StringValue(achievement.ID),
+		ReferenceName:    types.StringValue(achievement.Attr.ReferenceName),
+		VendorID:         types.StringValue(achievement.Attr.VendorIdentifier),
+		Points:           types.Int64Value(int64(achievement.Attr.Points)),
+		Repeatable:       types.BoolValue(achievement.Attr.Repeatable),
+		ShowBeforeEarned: types.BoolValue(achievement.Attr.ShowBeforeEarned),
+		Archived:         types.BoolValue(achievement.Attr.Archived),
+		Live:             types.BoolValue(achievementLive(achievement)),
+		Localizations:    []achievementLocalizationDataModel{},
+	}
+
+	for _, loc := range achievement.Localizations {
+		model.Localizations = append(model.Localizations, achievementLocalizationDataModel{
+			ID:                      types.StringValue(loc.ID),
+			Locale:                  types.StringValue(loc.Attr.Locale),
+			Name:                    types.StringValue(loc.Attr.Name),
+			BeforeEarnedDescription: types.StringValue(loc.Attr.BeforeEarnedDescription),
+			AfterEarnedDescription:  types.StringValue(loc.Attr.AfterEarnedDescription),
+		})
+	}
+
+	return model
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/fakeasc"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAchievementsDataSource(t *testing.T) {
+	// A small page limit makes the data source follow pagination.
+	srv, err := fakeasc.NewServer(fakeasc.Config{
+		KeyID:      testAccKeyID,
+		IssuerID:   testAccIssuerID,
+		PrivateKey: testAccPrivateKey,
+		PageLimit:  2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Close)
+
+	appID, gameCenterID := srv.AddApp("Test App", "com.example.test", "TESTAPP")
+	otherAppID := srv.Add("apps", map[string]any{"name": "Other App"}, nil)
+
+	ids := []string{}
+	for i := 0; i < 3; i++ {
+		ids = append(ids, srv.Add("gameCenterAchievements", map[string]any{
+			"referenceName":    fmt.Sprintf("Achievement %d", i),
+			"vendorIdentifier": fmt.Sprintf("com.example.test.achievement_%d", i),
+			"points":           10 * (i + 1),
+			"repeatable":       i == 1,
+			"showBeforeEarned": true,
+			"archived":         i == 2,
+		}, map[string]string{"gameCenterDetail": gameCenterID}))
+	}
+	for _, locale := range []string{"en-US", "de-DE", "fr-FR"} {
+		srv.Add("gameCenterAchievementLocalizations", map[string]any{
+			"locale":                  locale,
+			"name":                    "First Win " + locale,
+			"beforeEarnedDescription": "Win a match.",
+			"afterEarnedDescription":  "You won a match.",
+		}, map[string]string{"gameCenterAchievement": ids[0]})
+	}
+	srv.Add("gameCenterAchievementReleases", map[string]any{"live": true}, map[string]string{
+		"gameCenterAchievement": ids[1],
+		"gameCenterDetail":      gameCenterID,
+	})
+
+	groupID := srv.Add("gameCenterGroups", map[string]any{"referenceName": "Shared"}, nil)
+	groupAchievementID := srv.Add("gameCenterAchievements", map[string]any{
+		"referenceName":    "Shared Achievement",
+		"vendorIdentifier": "com.example.shared.achievement",
+		"points":           5,
+	}, map[string]string{"gameCenterGroup": groupID})
+
+	// Localizations and releases are included in the list, the achievements are not read one by one.
+	srv.InjectFault(fakeasc.Fault{Method: http.MethodGet, Path: "/v1/gameCenterAchievements/", Status: http.StatusInternalServerError})
+
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "game_center_id", gameCenterID),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.#", "3"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.0.id", ids[0]),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.0.reference_name", "Achievement 0"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.0.vendor_id", "com.example.test.achievement_0"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.0.points", "10"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.0.repeatable", "false"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.0.show_before_earned", "true"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.0.live", "false"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.0.localizations.#", "3"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.0.localizations.2.locale", "fr-FR"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.0.localizations.2.name", "First Win fr-FR"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.1.repeatable", "true"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.1.live", "true"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.1.localizations.#", "0"),
+		resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.2.archived", "true"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + fmt.Sprintf(`
+data "appstore_achievements" "test" {
+  game_center_id = %q
+  app_id         = %q
+}
+`, gameCenterID, appID),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccProviderConfig(srv) + fmt.Sprintf(`
+data "appstore_achievements" "test" {
+  app_id = %q
+}
+`, otherAppID),
+				ExpectError: regexp.MustCompile(`Game center not found`),
+			},
+			{
+				Config: testAccProviderConfig(srv) + fmt.Sprintf(`
+data "appstore_achievements" "test" {
+  game_center_id = %q
+}
+`, gameCenterID),
+				Check: check,
+			},
+			{
+				Config: testAccProviderConfig(srv) + fmt.Sprintf(`
+data "appstore_achievements" "test" {
+  app_id = %q
+}
+`, appID),
+				Check: check,
+			},
+			{
+				Config: testAccProviderConfig(srv) + fmt.Sprintf(`
+data "appstore_achievements" "test" {
+  game_center_group_id = %q
+}
+`, groupID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.#", "1"),
+					resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.0.id", groupAchievementID),
+					resource.TestCheckResourceAttr("data.appstore_achievements.test", "achievements.0.vendor_id", "com.example.shared.achievement"),
+				),
+			},
+		},
+	})
+}
@@ -2,8 +2,9 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
-	"github.com/alexprogrammr/appstore-go"
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,7 +23,7 @@ type appDataSourceModel struct {
 }
 
 type appDataSource struct {
-	client *appstore.Client
+	client *connect.Client
 }
 
 func NewAppDataSource() datasource.DataSource {
@@ -38,11 +39,11 @@ func (d *appDataSource) Configure(_ context.Context, req datasource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*appstore.Client)
+	client, ok := req.ProviderData.(*connect.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			"Expected *appstore.Client, got: %T. Please report this issue to the provider developers.",
+			"Expected *connect.Client, got: %T. Please report this issue to the provider developers.",
 		)
 		return
 	}
@@ -92,13 +93,17 @@ func (d *appDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	}
 
 	app, err := d.client.GetApp(ctx, appId)
-	if err != nil {
+	if connect.IsNotFound(err) {
 		resp.Diagnostics.AddError(
-			"Failed to read app",
-			err.Error(),
+			"App not found",
+			fmt.Sprintf("App with identifier %q does not exist.", appId),
 		)
 		return
 	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read app", err, nil)
+		return
+	}
 
 	state.Name = types.StringValue(app.Attr.Name)
 	state.SKU = types.StringValue(app.Attr.SKU)
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAppDataSource(t *testing.T) {
+	srv, _ := testAccServer(t)
+	appID, _ := srv.AddApp("Other App", "com.example.other", "OTHERAPP")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccAppDataSourceConfig(appID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.appstore_app.test", "id", appID),
+					resource.TestCheckResourceAttr("data.appstore_app.test", "name", "Other App"),
+					resource.TestCheckResourceAttr("data.appstore_app.test", "bundle_id", "com.example.other"),
+					resource.TestCheckResourceAttr("data.appstore_app.test", "sku", "OTHERAPP"),
+				),
+			},
+			{
+				Config:      testAccProviderConfig(srv) + testAccAppDataSourceConfig("404"),
+				ExpectError: regexp.MustCompile(`App not found`),
+			},
+		},
+	})
+}
+
+func testAccAppDataSourceConfig(appID string) string {
+	return fmt.Sprintf(`
+data "appstore_app" "test" {
+  id = %q
+}
+`, appID)
+}
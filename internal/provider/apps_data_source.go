@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/alexprogrammr/appstore-go"
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,7 +20,7 @@ type appsDataSourceModel struct {
 }
 
 type appsDataSource struct {
-	client *appstore.Client
+	client *connect.Client
 }
 
 func NewAppsDataSource() datasource.DataSource {
@@ -36,11 +36,11 @@ func (d *appsDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*appstore.Client)
+	client, ok := req.ProviderData.(*connect.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *appstore.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *connect.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -85,10 +85,7 @@ func (d *appsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, res
 
 	apps, err := d.client.ListApps(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to read apps",
-			err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Failed to read apps", err, nil)
 		return
 	}
 
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAppsDataSource(t *testing.T) {
+	srv, _ := testAccServer(t)
+
+	// Apps do not fit a single page, the data source follows the next links.
+	for i := 1; i < 60; i++ {
+		srv.AddApp(fmt.Sprintf("App %d", i), fmt.Sprintf("com.example.app%d", i), fmt.Sprintf("APP%d", i))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + `
+data "appstore_apps" "test" {
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.appstore_apps.test", "apps.#", "60"),
+					resource.TestCheckResourceAttr("data.appstore_apps.test", "apps.0.name", "Test App"),
+					resource.TestCheckResourceAttr("data.appstore_apps.test", "apps.0.bundle_id", "com.example.test"),
+					resource.TestCheckResourceAttr("data.appstore_apps.test", "apps.0.sku", "TESTAPP"),
+					resource.TestCheckResourceAttr("data.appstore_apps.test", "apps.59.name", "App 59"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// addClientError reports err as diagnostics. Errors returned by App Store Connect are reported
+// as one diagnostic per error object, attached to the schema attribute referenced by the source
+// pointer of the error object when the attributes map knows it. The attributes map is keyed by
+// names of App Store Connect attributes and relationships. The messages err was wrapped with, for
+// example, "failed to update achievement", lead the detail of every diagnostic.
+func addClientError(diags *diag.Diagnostics, summary string, err error, attributes map[string]string) {
+	var apiErr *connect.Error
+	if !errors.As(err, &apiErr) || len(apiErr.Errors) == 0 {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	wrapped := strings.TrimSuffix(strings.TrimSuffix(err.Error(), apiErr.Error()), ": ")
+
+	for _, e := range apiErr.Errors {
+		detail := errorDetail(e)
+		if wrapped != "" {
+			detail = wrapped + "\n\n" + detail
+		}
+
+		if attr, ok := errorAttribute(e, attributes); ok {
+			diags.AddAttributeError(path.Root(attr), summary, detail)
+			continue
+		}
+
+		diags.AddError(summary, detail)
+	}
+}
+
+func errorAttribute(e connect.ErrorObject, attributes map[string]string) (string, bool) {
+	if e.Source == nil {
+		return "", false
+	}
+
+	for _, prefix := range []string{"/data/attributes/", "/data/relationships/"} {
+		name, ok := strings.CutPrefix(e.Source.Pointer, prefix)
+		if !ok {
+			continue
+		}
+
+		name, _, _ = strings.Cut(name, "/")
+		attr, ok := attributes[name]
+		return attr, ok
+	}
+
+	return "", false
+}
+
+func errorDetail(e connect.ErrorObject) string {
+	detail := e.Title
+	if e.Detail != "" {
+		detail += "\n\n" + e.Detail
+	}
+
+	detail += fmt.Sprintf("\n\nApp Store Connect Error: status %s, code %s", e.Status, e.Code)
+	if e.Source != nil && e.Source.Pointer != "" {
+		detail += ", pointer " + e.Source.Pointer
+	}
+	if e.Source != nil && e.Source.Parameter != "" {
+		detail += ", parameter " + e.Source.Parameter
+	}
+
+	return detail
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestAddClientError(t *testing.T) {
+	attributes := map[string]string{
+		"vendorIdentifier": "vendor_id",
+		"gameCenterDetail": "game_center_id",
+	}
+
+	apiError := func(sources ...*connect.ErrorSource) error {
+		err := &connect.Error{StatusCode: 409}
+		for _, source := range sources {
+			err.Errors = append(err.Errors, connect.ErrorObject{
+				Status: "409",
+				Code:   "ENTITY_ERROR",
+				Title:  "The provided entity is invalid",
+				Detail: "Detail of the error.",
+				Source: source,
+			})
+		}
+		return fmt.Errorf("failed to create achievement: %w", err)
+	}
+
+	tests := []struct {
+		name    string
+		err     error
+		paths   []string
+		context string
+	}{
+		{
+			name:  "not an api error",
+			err:   errors.New("connection refused"),
+			paths: []string{""},
+		},
+		{
+			name:    "no error objects",
+			err:     fmt.Errorf("failed to create achievement: %w", &connect.Error{StatusCode: 500}),
+			paths:   []string{""},
+			context: "failed to create achievement",
+		},
+		{
+			name:    "attribute pointer",
+			err:     apiError(&connect.ErrorSource{Pointer: "/data/attributes/vendorIdentifier"}),
+			paths:   []string{"vendor_id"},
+			context: "failed to create achievement",
+		},
+		{
+			name:    "relationship pointer",
+			err:     apiError(&connect.ErrorSource{Pointer: "/data/relationships/gameCenterDetail"}),
+			paths:   []string{"game_center_id"},
+			context: "failed to create achievement",
+		},
+		{
+			name:    "nested pointer",
+			err:     apiError(&connect.ErrorSource{Pointer: "/data/relationships/gameCenterDetail/data/id"}),
+			paths:   []string{"game_center_id"},
+			context: "failed to create achievement",
+		},
+		{
+			name:    "unknown attribute",
+			err:     apiError(&connect.ErrorSource{Pointer: "/data/attributes/points"}),
+			paths:   []string{""},
+			context: "failed to create achievement",
+		},
+		{
+			name:    "parameter",
+			err:     apiError(&connect.ErrorSource{Parameter: "filter[vendorIdentifier]"}),
+			paths:   []string{""},
+			context: "failed to create achievement",
+		},
+		{
+			name:    "without source",
+			err:     apiError(nil),
+			paths:   []string{""},
+			context: "failed to create achievement",
+		},
+		{
+			name:    "several error objects",
+			err:     apiError(&connect.ErrorSource{Pointer: "/data/attributes/vendorIdentifier"}, nil),
+			paths:   []string{"vendor_id", ""},
+			context: "failed to create achievement",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			addClientError(&diags, "Failed to create achievement", tt.err, attributes)
+
+			if len(diags) != len(tt.paths) {
+				t.Fatalf("got %d diagnostics, want %d: %v", len(diags), len(tt.paths), diags)
+			}
+
+			for i, d := range diags {
+				if d.Severity() != diag.SeverityError || d.Summary() != "Failed to create achievement" {
+					t.Errorf("got diagnostic %s %q", d.Severity(), d.Summary())
+				}
+
+				got := ""
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					got = withPath.Path().String()
+				}
+				if got != tt.paths[i] {
+					t.Errorf("got path %q, want %q", got, tt.paths[i])
+				}
+
+				if !strings.HasPrefix(d.Detail(), tt.context) {
+					t.Errorf("got detail %q, want it to start with %q", d.Detail(), tt.context)
+				}
+			}
+		})
+	}
+}
@@ -2,8 +2,9 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
-	"github.com/alexprogrammr/appstore-go"
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,7 +23,7 @@ type gameCenterDataSourceModel struct {
 }
 
 type gameCenterDataSource struct {
-	client *appstore.Client
+	client *connect.Client
 }
 
 func NewGameCenterDataSource() datasource.DataSource {
@@ -38,7 +39,7 @@ func (d *gameCenterDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	d.client = req.ProviderData.(*appstore.Client)
+	d.client = req.ProviderData.(*connect.Client)
 }
 
 func (d *gameCenterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -83,22 +84,30 @@ func (d *gameCenterDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	app, err := d.client.GetApp(ctx, appId)
-	if err != nil {
+	if connect.IsNotFound(err) {
 		resp.Diagnostics.AddError(
-			"Failed to read app",
-			err.Error(),
+			"App not found",
+			fmt.Sprintf("App with identifier %q does not exist.", appId),
 		)
 		return
 	}
-
-	gameCenter, err := d.client.GetGameCenter(ctx, app)
 	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read app", err, nil)
+		return
+	}
+
+	gameCenter, err := d.client.GetGameCenter(ctx, app.ID)
+	if connect.IsNotFound(err) {
 		resp.Diagnostics.AddError(
-			"Failed to read game center",
-			err.Error(),
+			"Game center not found",
+			fmt.Sprintf("Game Center is not enabled for the app with identifier %q.", appId),
 		)
 		return
 	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read game center", err, nil)
+		return
+	}
 
 	state.ID = types.StringValue(gameCenter.ID)
 	state.ArcadeEnabled = types.BoolValue(gameCenter.Attr.ArcadeEnabled)
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGameCenterDataSource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+	obj, _ := srv.Get("gameCenterDetails", gameCenterID)
+	appID := obj.Relationships["app"][0]
+
+	srv.Update("gameCenterDetails", gameCenterID, map[string]any{"challengeEnabled": true})
+
+	// Apps without game center have no game center detail.
+	otherAppID := srv.Add("apps", map[string]any{"name": "Other App"}, nil)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccGameCenterDataSourceConfig(appID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.appstore_game_center.test", "id", gameCenterID),
+					resource.TestCheckResourceAttr("data.appstore_game_center.test", "app_id", appID),
+					resource.TestCheckResourceAttr("data.appstore_game_center.test", "arcade_enabled", "false"),
+					resource.TestCheckResourceAttr("data.appstore_game_center.test", "challenge_enabled", "true"),
+				),
+			},
+			{
+				Config:      testAccProviderConfig(srv) + testAccGameCenterDataSourceConfig(otherAppID),
+				ExpectError: regexp.MustCompile(`Game center not found`),
+			},
+		},
+	})
+}
+
+func testAccGameCenterDataSourceConfig(appID string) string {
+	return fmt.Sprintf(`
+data "appstore_game_center" "test" {
+  app_id = %q
+}
+`, appID)
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &gameCenterGroupResource{}
+	_ resource.ResourceWithConfigure   = &gameCenterGroupResource{}
+	_ resource.ResourceWithImportState = &gameCenterGroupResource{}
+)
+
+type gameCenterGroupResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ReferenceName types.String `tfsdk:"reference_name"`
+	GameCenterIDs types.Set    `tfsdk:"game_center_ids"`
+}
+
+// gameCenterGroupAttributes maps App Store Connect attributes and relationships of the group to the schema attributes.
+var gameCenterGroupAttributes = map[string]string{
+	"referenceName":     "reference_name",
+	"gameCenterDetails": "game_center_ids",
+}
+
+type gameCenterGroupResource struct {
+	client *connect.Client
+}
+
+func NewGameCenterGroupResource() resource.Resource {
+	return &gameCenterGroupResource{}
+}
+
+func (r *gameCenterGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_game_center_group"
+}
+
+func (r *gameCenterGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*connect.Client)
+}
+
+func (r *gameCenterGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages game center group, which shares achievements and leaderboards among several apps.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the game center group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reference_name": schema.StringAttribute{
+				Description: "An internal name of the game center group.",
+				Required:    true,
+			},
+			"game_center_ids": schema.SetAttribute{
+				Description: "Identifiers of the game centers of the apps in the group. " +
+					"If set, the resource owns the whole membership of the group, game centers not listed here are removed from the group.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *gameCenterGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := gameCenterGroupResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.CreateGameCenterGroup(ctx, &connect.GameCenterGroup{
+		ReferenceName: plan.ReferenceName.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to create game center group", err, gameCenterGroupAttributes)
+		return
+	}
+
+	plan.ID = types.StringValue(group.ID)
+
+	// The group is saved before its members are set, so that it is not orphaned if setting the members fails.
+	members := plan.GameCenterIDs
+	plan.GameCenterIDs = types.SetNull(types.StringType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.GameCenterIDs = r.replaceMembers(ctx, group.ID, members, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *gameCenterGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := gameCenterGroupResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.GetGameCenterGroupByID(ctx, state.ID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Game center group not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read game center group", err, gameCenterGroupAttributes)
+		return
+	}
+
+	ids, err := r.client.GetGameCenterGroupMembers(ctx, group.ID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read game center group members", err, nil)
+		return
+	}
+
+	members, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+
+	state.ReferenceName = types.StringValue(group.Attr.ReferenceName)
+	state.GameCenterIDs = members
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *gameCenterGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := gameCenterGroupResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateGameCenterGroup(ctx, connect.GameCenterGroupUpdate{
+		ID:            plan.ID.ValueString(),
+		ReferenceName: plan.ReferenceName.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to update game center group", err, gameCenterGroupAttributes)
+		return
+	}
+
+	plan.GameCenterIDs = r.replaceMembers(ctx, plan.ID.ValueString(), plan.GameCenterIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *gameCenterGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := gameCenterGroupResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteGameCenterGroupByID(ctx, state.ID.ValueString())
+	if err != nil && !connect.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Failed to delete game center group", err, gameCenterGroupAttributes)
+		return
+	}
+}
+
+func (r *gameCenterGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// replaceMembers sets the game centers of the group to the planned ones and returns them. Members that are not
+// configured are left as they are and read instead.
+func (r *gameCenterGroupResource) replaceMembers(ctx context.Context, id string, planned types.Set, diags *diag.Diagnostics) types.Set {
+	if planned.IsUnknown() || planned.IsNull() {
+		ids, err := r.client.GetGameCenterGroupMembers(ctx, id)
+		if err != nil {
+			addClientError(diags, "Failed to read game center group members", err, nil)
+			return planned
+		}
+
+		members, d := types.SetValueFrom(ctx, types.StringType, ids)
+		diags.Append(d...)
+		return members
+	}
+
+	ids := []string{}
+	diags.Append(planned.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
+		return planned
+	}
+
+	if err := r.client.ReplaceGameCenterGroupMembers(ctx, id, ids); err != nil {
+		addClientError(diags, "Failed to update game center group members", err, nil)
+	}
+
+	return planned
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccGameCenterGroupResource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+	_, otherGameCenterID := srv.AddApp("Other App", "com.example.other", "OTHERAPP")
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccGameCenterGroupConfig("Shared", gameCenterID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_game_center_group.test", &id),
+					resource.TestCheckResourceAttr("appstore_game_center_group.test", "reference_name", "Shared"),
+					resource.TestCheckResourceAttr("appstore_game_center_group.test", "game_center_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("appstore_game_center_group.test", "game_center_ids.*", gameCenterID),
+				),
+			},
+			{
+				ResourceName:      "appstore_game_center_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccGameCenterGroupConfig("Shared Games", gameCenterID, otherGameCenterID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_game_center_group.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appstore_game_center_group.test", "reference_name", "Shared Games"),
+					resource.TestCheckResourceAttr("appstore_game_center_group.test", "game_center_ids.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					srv.SetRelationship("gameCenterGroups", id, "gameCenterDetails", []string{gameCenterID})
+				},
+				Config: testAccProviderConfig(srv) + testAccGameCenterGroupConfig("Shared Games", gameCenterID, otherGameCenterID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_game_center_group.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_game_center_group.test", "game_center_ids.#", "2"),
+			},
+			{
+				PreConfig: func() {
+					srv.Remove("gameCenterGroups", id)
+				},
+				Config: testAccProviderConfig(srv) + testAccGameCenterGroupConfig("Shared Games", gameCenterID, otherGameCenterID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_game_center_group.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccGameCenterGroupConfig(name string, gameCenterIDs ...string) string {
+	return fmt.Sprintf(`
+resource "appstore_game_center_group" "test" {
+  reference_name  = %q
+  game_center_ids = ["%s"]
+}
+`, name, strings.Join(gameCenterIDs, `", "`))
+}
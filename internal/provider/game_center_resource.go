@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &gameCenterResource{}
+	_ resource.ResourceWithConfigure   = &gameCenterResource{}
+	_ resource.ResourceWithImportState = &gameCenterResource{}
+)
+
+type gameCenterResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	AppID            types.String `tfsdk:"app_id"`
+	ArcadeEnabled    types.Bool   `tfsdk:"arcade_enabled"`
+	ChallengeEnabled types.Bool   `tfsdk:"challenge_enabled"`
+}
+
+// gameCenterAttributes maps App Store Connect attributes and relationships of the game center to the schema attributes.
+var gameCenterAttributes = map[string]string{
+	"app":              "app_id",
+	"arcadeEnabled":    "arcade_enabled",
+	"challengeEnabled": "challenge_enabled",
+}
+
+type gameCenterResource struct {
+	client *connect.Client
+}
+
+func NewGameCenterResource() resource.Resource {
+	return &gameCenterResource{}
+}
+
+func (r *gameCenterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_game_center"
+}
+
+func (r *gameCenterResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*connect.Client)
+}
+
+func (r *gameCenterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enables Game Center for an app. If Game Center is already enabled, the resource adopts it. " +
+			"App Store Connect does not allow disabling Game Center, so destroying the resource only removes it from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the game center.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Description: "Identifier of the app to enable Game Center for. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"arcade_enabled": schema.BoolAttribute{
+				Description: "Indicates whether Game Center is enabled for the app on Apple Arcade. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"challenge_enabled": schema.BoolAttribute{
+				Description: "Indicates whether Game Center challenges are enabled for the app. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *gameCenterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := gameCenterResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appId := plan.AppID.ValueString()
+
+	app, err := r.client.GetApp(ctx, appId)
+	if connect.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("app_id"),
+			"App not found",
+			fmt.Sprintf("App with identifier %q does not exist.", appId),
+		)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read app", err, nil)
+		return
+	}
+
+	// Game Center cannot be disabled, an app that had it enabled before is adopted instead of failing the creation.
+	gameCenter, err := r.client.GetGameCenter(ctx, app.ID)
+	switch {
+	case connect.IsNotFound(err):
+		gameCenter, err = r.client.CreateGameCenter(ctx, app.ID, &connect.GameCenter{
+			ArcadeEnabled:    plan.ArcadeEnabled.ValueBool(),
+			ChallengeEnabled: plan.ChallengeEnabled.ValueBool(),
+		})
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Failed to enable game center", err, gameCenterAttributes)
+			return
+		}
+	case err != nil:
+		addClientError(&resp.Diagnostics, "Failed to read game center", err, nil)
+		return
+	default:
+		tflog.Info(ctx, "Game center is already enabled, adopting it", map[string]interface{}{"id": gameCenter.ID})
+
+		gameCenter, err = r.client.UpdateGameCenter(ctx, connect.GameCenterUpdate{
+			ID:               gameCenter.ID,
+			ArcadeEnabled:    plan.ArcadeEnabled.ValueBool(),
+			ChallengeEnabled: plan.ChallengeEnabled.ValueBool(),
+		})
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Failed to update game center", err, gameCenterAttributes)
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(gameCenter.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *gameCenterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := gameCenterResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gameCenter, err := r.client.GetGameCenterByID(ctx, state.ID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Game center not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read game center", err, gameCenterAttributes)
+		return
+	}
+
+	state.AppID = types.StringValue(gameCenter.RelatedID("app"))
+	state.ArcadeEnabled = types.BoolValue(gameCenter.Attr.ArcadeEnabled)
+	state.ChallengeEnabled = types.BoolValue(gameCenter.Attr.ChallengeEnabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *gameCenterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := gameCenterResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateGameCenter(ctx, connect.GameCenterUpdate{
+		ID:               plan.ID.ValueString(),
+		ArcadeEnabled:    plan.ArcadeEnabled.ValueBool(),
+		ChallengeEnabled: plan.ChallengeEnabled.ValueBool(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to update game center", err, gameCenterAttributes)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete leaves Game Center enabled, as App Store Connect does not allow disabling it, and only removes the resource from the state.
+func (r *gameCenterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := gameCenterResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, "Game center cannot be disabled, removing it from state only", map[string]interface{}{"id": state.ID.ValueString()})
+}
+
+func (r *gameCenterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGameCenterResource(t *testing.T) {
+	srv, _ := testAccServer(t)
+
+	appID := srv.Add("apps", map[string]any{
+		"name":     "New Game",
+		"bundleId": "com.example.new",
+		"sku":      "NEWGAME",
+	}, nil)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccGameCenterConfig(appID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_game_center.test", &id),
+					resource.TestCheckResourceAttr("appstore_game_center.test", "app_id", appID),
+					resource.TestCheckResourceAttr("appstore_game_center.test", "arcade_enabled", "false"),
+					resource.TestCheckResourceAttr("appstore_game_center.test", "challenge_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "appstore_game_center.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccGameCenterConfig(appID, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_game_center.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_game_center.test", "arcade_enabled", "true"),
+			},
+			{
+				PreConfig: func() {
+					srv.Update("gameCenterDetails", id, map[string]any{"challengeEnabled": false})
+				},
+				Config: testAccProviderConfig(srv) + testAccGameCenterConfig(appID, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_game_center.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_game_center.test", "challenge_enabled", "true"),
+			},
+			{
+				// Game Center stays enabled once the resource is destroyed.
+				Config: testAccProviderConfig(srv),
+				Check: func(_ *terraform.State) error {
+					if _, ok := srv.Get("gameCenterDetails", id); !ok {
+						return fmt.Errorf("game center %s was deleted", id)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccGameCenterResource_adopt(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	gameCenter, _ := srv.Get("gameCenterDetails", gameCenterID)
+	appID := gameCenter.Relationships["app"][0]
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccGameCenterConfig(appID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appstore_game_center.test", "id", gameCenterID),
+					func(_ *terraform.State) error {
+						gameCenter, _ := srv.Get("gameCenterDetails", gameCenterID)
+						if gameCenter.Attributes["challengeEnabled"] != true {
+							return fmt.Errorf("challenges of the adopted game center are not enabled")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccGameCenterConfig(appID string, arcadeEnabled bool) string {
+	return fmt.Sprintf(`
+resource "appstore_game_center" "test" {
+  app_id            = %q
+  arcade_enabled    = %t
+  challenge_enabled = true
+}
+`, appID, arcadeEnabled)
+}
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"os"
+)
+
+// imageFileChanged reports whether the image file is missing or its content
+// no longer matches the checksum of the uploaded image.
+func imageFileChanged(file string, sum string) (bool, error) {
+	if _, err := os.Stat(file); os.IsNotExist(err) {
+		return true, nil
+	}
+
+	image, err := os.ReadFile(file)
+	if err != nil {
+		return false, err
+	}
+
+	return checksum(image) != sum, nil
+}
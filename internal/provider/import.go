@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// importLocalizationState imports a localization either by its identifier or
+// by "<parent id>/<locale>", in which case the localization is looked up among
+// the localizations of the parent.
+func importLocalizationState[T any](ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, list func(context.Context, string) ([]connect.Resource[T], error), locale func(T) string) {
+	parentID, loc, ok := strings.Cut(req.ID, "/")
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	if parentID == "" || loc == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import identifier",
+			fmt.Sprintf("Expected import identifier in the format \"<id>\" or \"<parent id>/<locale>\", got: %q.", req.ID),
+		)
+		return
+	}
+
+	localizations, err := list(ctx, parentID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to list localizations", err, nil)
+		return
+	}
+
+	for _, l := range localizations {
+		if locale(l.Attr) == loc {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), l.ID)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Localization not found",
+		fmt.Sprintf("No localization with locale %q was found for %q.", loc, parentID),
+	)
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &leaderboardImageResource{}
+	_ resource.ResourceWithConfigure   = &leaderboardImageResource{}
+	_ resource.ResourceWithImportState = &leaderboardImageResource{}
+)
+
+type leaderboardImageResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	LocalizationID types.String `tfsdk:"leaderboard_localization_id"`
+	File           types.String `tfsdk:"file"`
+	Checksum       types.String `tfsdk:"checksum"`
+}
+
+// leaderboardImageAttributes maps App Store Connect attributes and relationships of the leaderboard image to the schema attributes.
+var leaderboardImageAttributes = map[string]string{
+	"gameCenterLeaderboardLocalization": "leaderboard_localization_id",
+	"fileName":                          "file",
+	"fileSize":                          "file",
+}
+
+type leaderboardImageResource struct {
+	client *connect.Client
+}
+
+func NewLeaderboardImageResource() resource.Resource {
+	return &leaderboardImageResource{}
+}
+
+func (r *leaderboardImageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leaderboard_image"
+}
+
+func (r *leaderboardImageResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*connect.Client)
+}
+
+func (r *leaderboardImageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages game center leaderboard localization images.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the leaderboard image.",
+				Computed:    true,
+			},
+			"leaderboard_localization_id": schema.StringAttribute{
+				Description: "Identifier of the leaderboard localization to associate the image with. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file": schema.StringAttribute{
+				Description: "Path to the image file. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				Description: "MD5 checksum of the image. Resource will be re-created if this value is changed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *leaderboardImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	state := leaderboardImageResourceModel{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localizationID := state.LocalizationID.ValueString()
+	if localizationID == "" {
+		resp.Diagnostics.AddError(
+			"Missing required attribute",
+			"Attribute 'leaderboard_localization_id' is required to create a leaderboard image.",
+		)
+		return
+	}
+
+	filePath := state.File.ValueString()
+	if filePath == "" {
+		resp.Diagnostics.AddError(
+			"Missing required attribute",
+			"Attribute 'file' is required to create a leaderboard image.",
+		)
+		return
+	}
+
+	image, err := os.ReadFile(filePath)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read image file",
+			err.Error(),
+		)
+		return
+	}
+
+	localization, err := r.client.GetLeaderboardLocalizationByID(ctx, localizationID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to get leaderboard localization", err, nil)
+		return
+	}
+
+	asset, err := r.client.CreateLeaderboardImage(ctx, localization.ID, filepath.Base(filePath), image)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to create leaderboard image", err, leaderboardImageAttributes)
+		return
+	}
+
+	state.ID = types.StringValue(asset.ID)
+	state.Checksum = types.StringValue(checksum(image))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := leaderboardImageResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	image, err := r.client.GetLeaderboardImageByID(ctx, state.ID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Leaderboard image not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read leaderboard image", err, leaderboardImageAttributes)
+		return
+	}
+
+	state.LocalizationID = types.StringValue(image.RelatedID("gameCenterLeaderboardLocalization"))
+
+	// Source file of imported images is unknown, it stays empty until the image is re-created.
+	if !state.File.IsNull() {
+		changed, err := imageFileChanged(state.File.ValueString(), state.Checksum.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read image file",
+				err.Error(),
+			)
+			return
+		}
+
+		if changed {
+			state.File = types.StringValue("")
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *leaderboardImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := leaderboardImageResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteLeaderboardImageByID(ctx, state.ID.ValueString())
+	if err != nil && !connect.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Failed to delete leaderboard image", err, leaderboardImageAttributes)
+		return
+	}
+}
+
+func (r *leaderboardImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/fakeasc"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccLeaderboardImageResource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+	file := testAccImageFile(t, "image.png", []byte("first image"))
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardImageConfig(gameCenterID, file),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_leaderboard_image.test", &id),
+					resource.TestCheckResourceAttrPair("appstore_leaderboard_image.test", "leaderboard_localization_id", "appstore_leaderboard_localization.test", "id"),
+					resource.TestCheckResourceAttr("appstore_leaderboard_image.test", "file", file),
+					resource.TestCheckResourceAttr("appstore_leaderboard_image.test", "checksum", checksum([]byte("first image"))),
+					testAccCheckUpload(srv, &id, "first image"),
+				),
+			},
+			{
+				ResourceName:            "appstore_leaderboard_image.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file", "checksum"},
+			},
+			{
+				// Changing the image content re-uploads the image.
+				PreConfig: func() {
+					if err := os.WriteFile(file, []byte("second image"), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardImageConfig(gameCenterID, file),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_image.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_leaderboard_image.test", &id),
+					resource.TestCheckResourceAttr("appstore_leaderboard_image.test", "checksum", checksum([]byte("second image"))),
+					testAccCheckUpload(srv, &id, "second image"),
+				),
+			},
+			{
+				PreConfig: func() {
+					srv.Remove("gameCenterLeaderboardImages", id)
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardImageConfig(gameCenterID, file),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_image.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccLeaderboardImageResource_chunkFailure(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+	file := testAccImageFile(t, "image.png", []byte("image"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					srv.InjectFault(fakeasc.Fault{
+						Method: http.MethodPut,
+						Path:   "/upload/",
+						Status: http.StatusInternalServerError,
+					})
+				},
+				Config:      testAccProviderConfig(srv) + testAccLeaderboardImageConfig(gameCenterID, file),
+				ExpectError: regexp.MustCompile(`failed to upload asset chunk`),
+			},
+			{
+				PreConfig: func() {
+					testAccCheckNoLeaderboardImages(t, srv)
+					srv.ClearFaults()
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardImageConfig(gameCenterID, file),
+			},
+		},
+	})
+}
+
+func TestAccLeaderboardImageResource_processingFailure(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+	file := testAccImageFile(t, "image.png", []byte("image"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The upload is acknowledged but its data is lost, so the
+				// asset fails because its size does not match the reservation.
+				PreConfig: func() {
+					srv.InjectFault(fakeasc.Fault{
+						Method: http.MethodPut,
+						Path:   "/upload/",
+						Status: http.StatusOK,
+					})
+				},
+				Config:      testAccProviderConfig(srv) + testAccLeaderboardImageConfig(gameCenterID, file),
+				ExpectError: regexp.MustCompile(`asset processing failed:\s+IMAGE_INCORRECT_SIZE`),
+			},
+			{
+				PreConfig: func() {
+					testAccCheckNoLeaderboardImages(t, srv)
+					srv.ClearFaults()
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardImageConfig(gameCenterID, file),
+			},
+		},
+	})
+}
+
+// testAccCheckNoLeaderboardImages fails the test if an image, including a
+// reservation of a failed upload, is left on the server.
+func testAccCheckNoLeaderboardImages(t *testing.T, srv *fakeasc.Server) {
+	t.Helper()
+
+	if images := srv.List("gameCenterLeaderboardImages"); len(images) != 0 {
+		t.Fatalf("got %d leaderboard images left on the server, want none", len(images))
+	}
+}
+
+func testAccLeaderboardImageConfig(gameCenterID, file string) string {
+	return testAccLeaderboardLocalizationConfig(gameCenterID, "High Score", "") + fmt.Sprintf(`
+resource "appstore_leaderboard_image" "test" {
+  leaderboard_localization_id = appstore_leaderboard_localization.test.id
+  file                        = %q
+}
+`, file)
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &leaderboardLocalizationResource{}
+	_ resource.ResourceWithConfigure   = &leaderboardLocalizationResource{}
+	_ resource.ResourceWithImportState = &leaderboardLocalizationResource{}
+)
+
+type leaderboardLocalizationResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	LeaderboardID           types.String `tfsdk:"leaderboard_id"`
+	Locale                  types.String `tfsdk:"locale"`
+	Name                    types.String `tfsdk:"name"`
+	FormatterOverride       types.String `tfsdk:"formatter_override"`
+	FormatterSuffix         types.String `tfsdk:"formatter_suffix"`
+	FormatterSuffixSingular types.String `tfsdk:"formatter_suffix_singular"`
+}
+
+// leaderboardLocalizationAttributes maps App Store Connect attributes and relationships of the leaderboard localization to the schema attributes.
+var leaderboardLocalizationAttributes = map[string]string{
+	"gameCenterLeaderboard":   "leaderboard_id",
+	"locale":                  "locale",
+	"name":                    "name",
+	"formatterOverride":       "formatter_override",
+	"formatterSuffix":         "formatter_suffix",
+	"formatterSuffixSingular": "formatter_suffix_singular",
+}
+
+type leaderboardLocalizationResource struct {
+	client *connect.Client
+}
+
+func NewLeaderboardLocalizationResource() resource.Resource {
+	return &leaderboardLocalizationResource{}
+}
+
+func (r *leaderboardLocalizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leaderboard_localization"
+}
+
+func (r *leaderboardLocalizationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*connect.Client)
+}
+
+func (r *leaderboardLocalizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages game center leaderboard localization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the leaderboard localization.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"leaderboard_id": schema.StringAttribute{
+				Description: "Identifier of the leaderboard to associate the localization with. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"locale": schema.StringAttribute{
+				Description: "Locale of the leaderboard localization. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the leaderboard.",
+				Required:    true,
+			},
+			"formatter_override": schema.StringAttribute{
+				Description: "Score format that overrides the default format of the leaderboard for this locale.",
+				Optional:    true,
+			},
+			"formatter_suffix": schema.StringAttribute{
+				Description: "Suffix displayed after plural scores, for example, points.",
+				Optional:    true,
+			},
+			"formatter_suffix_singular": schema.StringAttribute{
+				Description: "Suffix displayed after a singular score, for example, point.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *leaderboardLocalizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	state := leaderboardLocalizationResourceModel{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	leaderboardID := state.LeaderboardID.ValueString()
+	if leaderboardID == "" {
+		resp.Diagnostics.AddError(
+			"Missing required attribute",
+			"Attribute 'leaderboard_id' is required to create a leaderboard localization.",
+		)
+		return
+	}
+
+	leaderboard, err := r.client.GetLeaderboardByID(ctx, leaderboardID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read leaderboard", err, nil)
+		return
+	}
+
+	localization, err := r.client.CreateLeaderboardLocalization(ctx, leaderboard.ID, &connect.LeaderboardLocalization{
+		Locale:                  state.Locale.ValueString(),
+		Name:                    state.Name.ValueString(),
+		FormatterOverride:       state.FormatterOverride.ValueStringPointer(),
+		FormatterSuffix:         state.FormatterSuffix.ValueStringPointer(),
+		FormatterSuffixSingular: state.FormatterSuffixSingular.ValueStringPointer(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to create leaderboard localization", err, leaderboardLocalizationAttributes)
+		return
+	}
+
+	state.ID = types.StringValue(localization.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardLocalizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := leaderboardLocalizationResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localization, err := r.client.GetLeaderboardLocalizationByID(ctx, state.ID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Leaderboard localization not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read leaderboard localization", err, leaderboardLocalizationAttributes)
+		return
+	}
+
+	state.LeaderboardID = types.StringValue(localization.RelatedID("gameCenterLeaderboard"))
+	state.Locale = types.StringValue(localization.Attr.Locale)
+	state.Name = types.StringValue(localization.Attr.Name)
+	state.FormatterOverride = types.StringPointerValue(localization.Attr.FormatterOverride)
+	state.FormatterSuffix = types.StringPointerValue(localization.Attr.FormatterSuffix)
+	state.FormatterSuffixSingular = types.StringPointerValue(localization.Attr.FormatterSuffixSingular)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardLocalizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := leaderboardLocalizationResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateLeaderboardLocalization(ctx, connect.LeaderboardLocalizationUpdate{
+		ID:                      plan.ID.ValueString(),
+		Name:                    plan.Name.ValueString(),
+		FormatterOverride:       plan.FormatterOverride.ValueStringPointer(),
+		FormatterSuffix:         plan.FormatterSuffix.ValueStringPointer(),
+		FormatterSuffixSingular: plan.FormatterSuffixSingular.ValueStringPointer(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to update leaderboard localization", err, leaderboardLocalizationAttributes)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *leaderboardLocalizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := leaderboardLocalizationResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteLeaderboardLocalizationByID(ctx, state.ID.ValueString())
+	if err != nil && !connect.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Failed to delete leaderboard localization", err, leaderboardLocalizationAttributes)
+		return
+	}
+}
+
+// ImportState accepts either the localization identifier or "<leaderboard id>/<locale>".
+func (r *leaderboardLocalizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importLocalizationState(ctx, req, resp, r.client.ListLeaderboardLocalizations, func(l connect.LeaderboardLocalization) string {
+		return l.Locale
+	})
+}
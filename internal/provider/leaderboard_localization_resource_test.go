@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLeaderboardLocalizationResource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardLocalizationConfig(gameCenterID, "High Score", `
+  formatter_suffix          = " points"
+  formatter_suffix_singular = " point"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_leaderboard_localization.test", &id),
+					resource.TestCheckResourceAttrPair("appstore_leaderboard_localization.test", "leaderboard_id", "appstore_leaderboard.test", "id"),
+					resource.TestCheckResourceAttr("appstore_leaderboard_localization.test", "locale", "en-US"),
+					resource.TestCheckResourceAttr("appstore_leaderboard_localization.test", "name", "High Score"),
+					resource.TestCheckResourceAttr("appstore_leaderboard_localization.test", "formatter_suffix", " points"),
+					resource.TestCheckResourceAttr("appstore_leaderboard_localization.test", "formatter_suffix_singular", " point"),
+					resource.TestCheckNoResourceAttr("appstore_leaderboard_localization.test", "formatter_override"),
+				),
+			},
+			{
+				ResourceName:      "appstore_leaderboard_localization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName: "appstore_leaderboard_localization.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["appstore_leaderboard.test"].Primary.ID + "/en-US", nil
+				},
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardLocalizationConfig(gameCenterID, "Best Score", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_localization.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appstore_leaderboard_localization.test", "name", "Best Score"),
+					resource.TestCheckNoResourceAttr("appstore_leaderboard_localization.test", "formatter_suffix"),
+					resource.TestCheckNoResourceAttr("appstore_leaderboard_localization.test", "formatter_suffix_singular"),
+				),
+			},
+			{
+				PreConfig: func() {
+					srv.Update("gameCenterLeaderboardLocalizations", id, map[string]any{"formatterOverride": "INTEGER"})
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardLocalizationConfig(gameCenterID, "Best Score", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_localization.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckNoResourceAttr("appstore_leaderboard_localization.test", "formatter_override"),
+			},
+			{
+				PreConfig: func() {
+					srv.Remove("gameCenterLeaderboardLocalizations", id)
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardLocalizationConfig(gameCenterID, "Best Score", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_localization.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccLeaderboardLocalizationConfig(gameCenterID, name, extra string) string {
+	return testAccLeaderboardConfig(gameCenterID, "High Score", "") + fmt.Sprintf(`
+resource "appstore_leaderboard_localization" "test" {
+  leaderboard_id = appstore_leaderboard.test.id
+  locale         = "en-US"
+  name           = %q
+%s}
+`, name, extra)
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = &leaderboardResource{}
+	_ resource.ResourceWithConfigure        = &leaderboardResource{}
+	_ resource.ResourceWithImportState      = &leaderboardResource{}
+	_ resource.ResourceWithConfigValidators = &leaderboardResource{}
+)
+
+type leaderboardResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	GameCenterID        types.String `tfsdk:"game_center_id"`
+	GameCenterGroupID   types.String `tfsdk:"game_center_group_id"`
+	ReferenceName       types.String `tfsdk:"reference_name"`
+	VendorID            types.String `tfsdk:"vendor_id"`
+	ScoreFormat         types.String `tfsdk:"score_format"`
+	SortOrder           types.String `tfsdk:"sort_order"`
+	SubmissionType      types.String `tfsdk:"submission_type"`
+	ScoreRangeStart     types.String `tfsdk:"score_range_start"`
+	ScoreRangeEnd       types.String `tfsdk:"score_range_end"`
+	RecurrenceStartDate types.String `tfsdk:"recurrence_start_date"`
+	RecurrenceDuration  types.String `tfsdk:"recurrence_duration"`
+	Archived            types.Bool   `tfsdk:"archived"`
+}
+
+// leaderboardAttributes maps App Store Connect attributes and relationships of the leaderboard to the schema attributes.
+var leaderboardAttributes = map[string]string{
+	"gameCenterDetail":    "game_center_id",
+	"gameCenterGroup":     "game_center_group_id",
+	"referenceName":       "reference_name",
+	"vendorIdentifier":    "vendor_id",
+	"defaultFormatter":    "score_format",
+	"scoreSortType":       "sort_order",
+	"submissionType":      "submission_type",
+	"scoreRangeStart":     "score_range_start",
+	"scoreRangeEnd":       "score_range_end",
+	"recurrenceStartDate": "recurrence_start_date",
+	"recurrenceDuration":  "recurrence_duration",
+	"archived":            "archived",
+}
+
+type leaderboardResource struct {
+	client *connect.Client
+}
+
+func NewLeaderboardResource() resource.Resource {
+	return &leaderboardResource{}
+}
+
+func (r *leaderboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leaderboard"
+}
+
+func (r *leaderboardResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*connect.Client)
+}
+
+func (r *leaderboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages game center leaderboard.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the leaderboard.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"game_center_id": schema.StringAttribute{
+				Description: "Identifier of the game center to associate the leaderboard with. Conflicts with game_center_group_id. " +
+					"Resource will be re-created if this value is changed.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"game_center_group_id": schema.StringAttribute{
+				Description: "Identifier of the game center group to associate the leaderboard with, sharing it among the apps of the group. " +
+					"Conflicts with game_center_id. Resource will be re-created if this value is changed.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reference_name": schema.StringAttribute{
+				Description: "An internal name of the leaderboard.",
+				Required:    true,
+			},
+			"vendor_id": schema.StringAttribute{
+				Description: "A chosen alphanumeric identifier of the leaderboard. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"score_format": schema.StringAttribute{
+				Description: "The format of the scores, for example, INTEGER, DECIMAL_POINT_2_PLACE, ELAPSED_TIME_SECOND or MONEY_DOLLAR.",
+				Required:    true,
+			},
+			"sort_order": schema.StringAttribute{
+				Description: "The order in which the leaderboard sorts scores, either ASC or DESC.",
+				Required:    true,
+			},
+			"submission_type": schema.StringAttribute{
+				Description: "The score the leaderboard keeps for a player, either BEST_SCORE or MOST_RECENT_SCORE.",
+				Required:    true,
+			},
+			"score_range_start": schema.StringAttribute{
+				Description: "The lowest score the leaderboard accepts.",
+				Optional:    true,
+			},
+			"score_range_end": schema.StringAttribute{
+				Description: "The highest score the leaderboard accepts.",
+				Optional:    true,
+			},
+			"recurrence_start_date": schema.StringAttribute{
+				Description: "The date and time in RFC 3339 format when a recurring leaderboard starts, for example, 2024-01-01T00:00:00Z.",
+				Optional:    true,
+			},
+			"recurrence_duration": schema.StringAttribute{
+				Description: "The ISO 8601 duration of each occurrence of a recurring leaderboard, for example, P1D.",
+				Optional:    true,
+			},
+			"archived": schema.BoolAttribute{
+				Description: "An indication of whether the leaderboard is archived. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *leaderboardResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return ownerConfigValidators()
+}
+
+func (r *leaderboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	state := leaderboardResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner, ok := readOwner(ctx, r.client, state.GameCenterID, state.GameCenterGroupID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	leaderboard, err := r.client.CreateLeaderboard(ctx, owner, &connect.Leaderboard{
+		ReferenceName:       state.ReferenceName.ValueString(),
+		VendorIdentifier:    state.VendorID.ValueString(),
+		DefaultFormatter:    state.ScoreFormat.ValueString(),
+		SubmissionType:      state.SubmissionType.ValueString(),
+		ScoreSortType:       state.SortOrder.ValueString(),
+		ScoreRangeStart:     state.ScoreRangeStart.ValueStringPointer(),
+		ScoreRangeEnd:       state.ScoreRangeEnd.ValueStringPointer(),
+		RecurrenceStartDate: state.RecurrenceStartDate.ValueStringPointer(),
+		RecurrenceDuration:  state.RecurrenceDuration.ValueStringPointer(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to create leaderboard", err, leaderboardAttributes)
+		return
+	}
+
+	state.ID = types.StringValue(leaderboard.ID)
+
+	// Leaderboards are always created active, archive it with a follow-up update if requested. The created
+	// leaderboard is saved first, so that it is not lost from state if archiving fails.
+	if state.Archived.ValueBool() {
+		state.Archived = types.BoolValue(false)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Archived = types.BoolValue(true)
+		if _, err := r.client.UpdateLeaderboard(ctx, leaderboardUpdate(state)); err != nil {
+			addClientError(&resp.Diagnostics, "Failed to archive leaderboard", err, leaderboardAttributes)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := leaderboardResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	leaderboard, err := r.client.GetLeaderboardByID(ctx, state.ID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Leaderboard not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read leaderboard", err, leaderboardAttributes)
+		return
+	}
+
+	state.GameCenterID, state.GameCenterGroupID = ownerValues(leaderboard)
+	state.ReferenceName = types.StringValue(leaderboard.Attr.ReferenceName)
+	state.VendorID = types.StringValue(leaderboard.Attr.VendorIdentifier)
+	state.ScoreFormat = types.StringValue(leaderboard.Attr.DefaultFormatter)
+	state.SortOrder = types.StringValue(leaderboard.Attr.ScoreSortType)
+	state.SubmissionType = types.StringValue(leaderboard.Attr.SubmissionType)
+	state.ScoreRangeStart = types.StringPointerValue(leaderboard.Attr.ScoreRangeStart)
+	state.ScoreRangeEnd = types.StringPointerValue(leaderboard.Attr.ScoreRangeEnd)
+	state.RecurrenceStartDate = types.StringPointerValue(leaderboard.Attr.RecurrenceStartDate)
+	state.RecurrenceDuration = types.StringPointerValue(leaderboard.Attr.RecurrenceDuration)
+	state.Archived = types.BoolValue(leaderboard.Attr.Archived)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := leaderboardResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateLeaderboard(ctx, leaderboardUpdate(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to update leaderboard", err, leaderboardAttributes)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *leaderboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := leaderboardResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteLeaderboardByID(ctx, state.ID.ValueString())
+	if err != nil && !connect.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Failed to delete leaderboard", err, leaderboardAttributes)
+		return
+	}
+}
+
+func leaderboardUpdate(model leaderboardResourceModel) connect.LeaderboardUpdate {
+	return connect.LeaderboardUpdate{
+		ID:                  model.ID.ValueString(),
+		ReferenceName:       model.ReferenceName.ValueString(),
+		DefaultFormatter:    model.ScoreFormat.ValueString(),
+		SubmissionType:      model.SubmissionType.ValueString(),
+		ScoreSortType:       model.SortOrder.ValueString(),
+		ScoreRangeStart:     model.ScoreRangeStart.ValueStringPointer(),
+		ScoreRangeEnd:       model.ScoreRangeEnd.ValueStringPointer(),
+		RecurrenceStartDate: model.RecurrenceStartDate.ValueStringPointer(),
+		RecurrenceDuration:  model.RecurrenceDuration.ValueStringPointer(),
+		Archived:            model.Archived.ValueBool(),
+	}
+}
+
+func (r *leaderboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
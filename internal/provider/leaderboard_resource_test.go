@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/fakeasc"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLeaderboardResource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardConfig(gameCenterID, "High Score", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_leaderboard.test", &id),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "game_center_id", gameCenterID),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "reference_name", "High Score"),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "vendor_id", "com.example.test.high_score"),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "score_format", "INTEGER"),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "sort_order", "DESC"),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "submission_type", "BEST_SCORE"),
+					resource.TestCheckNoResourceAttr("appstore_leaderboard.test", "score_range_start"),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "archived", "false"),
+				),
+			},
+			{
+				ResourceName:      "appstore_leaderboard.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardConfig(gameCenterID, "Best Score", `
+  score_range_start = "0"
+  score_range_end   = "1000"
+  archived          = true
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "reference_name", "Best Score"),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "score_range_start", "0"),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "score_range_end", "1000"),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "archived", "true"),
+				),
+			},
+			{
+				// Optional attributes removed from the configuration are cleared remotely.
+				Config: testAccProviderConfig(srv) + testAccLeaderboardConfig(gameCenterID, "Best Score", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("appstore_leaderboard.test", "score_range_start"),
+					resource.TestCheckNoResourceAttr("appstore_leaderboard.test", "score_range_end"),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "archived", "false"),
+				),
+			},
+			{
+				PreConfig: func() {
+					srv.Update("gameCenterLeaderboards", id, map[string]any{"scoreSortType": "ASC"})
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardConfig(gameCenterID, "Best Score", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_leaderboard.test", "sort_order", "DESC"),
+			},
+			{
+				PreConfig: func() {
+					srv.Remove("gameCenterLeaderboards", id)
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardConfig(gameCenterID, "Best Score", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccLeaderboardResource_archived(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardConfig(gameCenterID, "High Score", `
+  archived = true
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_leaderboard.test", &id),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "archived", "true"),
+					func(_ *terraform.State) error {
+						obj, _ := srv.Get("gameCenterLeaderboards", id)
+						if obj.Attributes["archived"] != true {
+							return fmt.Errorf("leaderboard %s is not archived remotely", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccLeaderboardResource_archiveFailure(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	config := testAccProviderConfig(srv) + testAccLeaderboardConfig(gameCenterID, "High Score", `
+  archived = true
+`)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					srv.InjectFault(fakeasc.Fault{Method: http.MethodPatch, Path: "/v1/gameCenterLeaderboards/", Status: http.StatusConflict, Times: 1})
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Failed to archive leaderboard`),
+			},
+			{
+				// The leaderboard created before the failure is kept in state, it is not created again with a
+				// duplicate vendor identifier.
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_leaderboard.test", &id),
+					resource.TestCheckResourceAttr("appstore_leaderboard.test", "archived", "true"),
+					func(_ *terraform.State) error {
+						obj, _ := srv.Get("gameCenterLeaderboards", id)
+						if obj.Attributes["archived"] != true {
+							return fmt.Errorf("leaderboard %s is not archived remotely", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccLeaderboardResource_group(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	config := testAccProviderConfig(srv) + testAccGameCenterGroupConfig("Shared", gameCenterID) +
+		strings.Replace(testAccLeaderboardConfig("", "High Score", ""),
+			`game_center_id  = ""`, `game_center_group_id = appstore_game_center_group.test.id`, 1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("appstore_leaderboard.test", "game_center_group_id", "appstore_game_center_group.test", "id"),
+					resource.TestCheckNoResourceAttr("appstore_leaderboard.test", "game_center_id"),
+				),
+			},
+			{
+				ResourceName:      "appstore_leaderboard.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLeaderboardConfig(gameCenterID, name, extra string) string {
+	return fmt.Sprintf(`
+resource "appstore_leaderboard" "test" {
+  game_center_id  = %q
+  reference_name  = %q
+  vendor_id       = "com.example.test.high_score"
+  score_format    = "INTEGER"
+  sort_order      = "DESC"
+  submission_type = "BEST_SCORE"
+%s}
+`, gameCenterID, name, extra)
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &leaderboardSetImageResource{}
+	_ resource.ResourceWithConfigure   = &leaderboardSetImageResource{}
+	_ resource.ResourceWithImportState = &leaderboardSetImageResource{}
+)
+
+type leaderboardSetImageResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	LocalizationID types.String `tfsdk:"leaderboard_set_localization_id"`
+	File           types.String `tfsdk:"file"`
+	Checksum       types.String `tfsdk:"checksum"`
+}
+
+// leaderboardSetImageAttributes maps App Store Connect attributes and relationships of the leaderboard set image to the schema attributes.
+var leaderboardSetImageAttributes = map[string]string{
+	"gameCenterLeaderboardSetLocalization": "leaderboard_set_localization_id",
+	"fileName":                             "file",
+	"fileSize":                             "file",
+}
+
+type leaderboardSetImageResource struct {
+	client *connect.Client
+}
+
+func NewLeaderboardSetImageResource() resource.Resource {
+	return &leaderboardSetImageResource{}
+}
+
+func (r *leaderboardSetImageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leaderboard_set_image"
+}
+
+func (r *leaderboardSetImageResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*connect.Client)
+}
+
+func (r *leaderboardSetImageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages game center leaderboard set localization images.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the leaderboard set image.",
+				Computed:    true,
+			},
+			"leaderboard_set_localization_id": schema.StringAttribute{
+				Description: "Identifier of the leaderboard set localization to associate the image with. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file": schema.StringAttribute{
+				Description: "Path to the image file. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				Description: "MD5 checksum of the image. Resource will be re-created if this value is changed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *leaderboardSetImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	state := leaderboardSetImageResourceModel{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localizationID := state.LocalizationID.ValueString()
+	if localizationID == "" {
+		resp.Diagnostics.AddError(
+			"Missing required attribute",
+			"Attribute 'leaderboard_set_localization_id' is required to create a leaderboard set image.",
+		)
+		return
+	}
+
+	filePath := state.File.ValueString()
+	if filePath == "" {
+		resp.Diagnostics.AddError(
+			"Missing required attribute",
+			"Attribute 'file' is required to create a leaderboard set image.",
+		)
+		return
+	}
+
+	image, err := os.ReadFile(filePath)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read image file",
+			err.Error(),
+		)
+		return
+	}
+
+	localization, err := r.client.GetLeaderboardSetLocalizationByID(ctx, localizationID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to get leaderboard set localization", err, nil)
+		return
+	}
+
+	asset, err := r.client.CreateLeaderboardSetImage(ctx, localization.ID, filepath.Base(filePath), image)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to create leaderboard set image", err, leaderboardSetImageAttributes)
+		return
+	}
+
+	state.ID = types.StringValue(asset.ID)
+	state.Checksum = types.StringValue(checksum(image))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardSetImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := leaderboardSetImageResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	image, err := r.client.GetLeaderboardSetImageByID(ctx, state.ID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Leaderboard set image not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read leaderboard set image", err, leaderboardSetImageAttributes)
+		return
+	}
+
+	state.LocalizationID = types.StringValue(image.RelatedID("gameCenterLeaderboardSetLocalization"))
+
+	// Source file of imported images is unknown, it stays empty until the image is re-created.
+	if !state.File.IsNull() {
+		changed, err := imageFileChanged(state.File.ValueString(), state.Checksum.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read image file",
+				err.Error(),
+			)
+			return
+		}
+
+		if changed {
+			state.File = types.StringValue("")
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardSetImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *leaderboardSetImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := leaderboardSetImageResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteLeaderboardSetImageByID(ctx, state.ID.ValueString())
+	if err != nil && !connect.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Failed to delete leaderboard set image", err, leaderboardSetImageAttributes)
+		return
+	}
+}
+
+func (r *leaderboardSetImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccLeaderboardSetImageResource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+	file := testAccImageFile(t, "image.png", []byte("first image"))
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetImageConfig(gameCenterID, file),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_leaderboard_set_image.test", &id),
+					resource.TestCheckResourceAttrPair("appstore_leaderboard_set_image.test", "leaderboard_set_localization_id", "appstore_leaderboard_set_localization.test", "id"),
+					resource.TestCheckResourceAttr("appstore_leaderboard_set_image.test", "file", file),
+					resource.TestCheckResourceAttr("appstore_leaderboard_set_image.test", "checksum", checksum([]byte("first image"))),
+					testAccCheckUpload(srv, &id, "first image"),
+				),
+			},
+			{
+				ResourceName:            "appstore_leaderboard_set_image.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"file", "checksum"},
+			},
+			{
+				// Changing the image content re-uploads the image.
+				PreConfig: func() {
+					if err := os.WriteFile(file, []byte("second image"), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetImageConfig(gameCenterID, file),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_set_image.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_leaderboard_set_image.test", &id),
+					resource.TestCheckResourceAttr("appstore_leaderboard_set_image.test", "checksum", checksum([]byte("second image"))),
+					testAccCheckUpload(srv, &id, "second image"),
+				),
+			},
+			{
+				PreConfig: func() {
+					srv.Remove("gameCenterLeaderboardSetImages", id)
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetImageConfig(gameCenterID, file),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_set_image.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccLeaderboardSetImageConfig(gameCenterID, file string) string {
+	return testAccLeaderboardSetLocalizationConfig(gameCenterID, "Season One") + fmt.Sprintf(`
+resource "appstore_leaderboard_set_image" "test" {
+  leaderboard_set_localization_id = appstore_leaderboard_set_localization.test.id
+  file                            = %q
+}
+`, file)
+}
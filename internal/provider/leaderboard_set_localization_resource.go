@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &leaderboardSetLocalizationResource{}
+	_ resource.ResourceWithConfigure   = &leaderboardSetLocalizationResource{}
+	_ resource.ResourceWithImportState = &leaderboardSetLocalizationResource{}
+)
+
+type leaderboardSetLocalizationResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	LeaderboardSetID types.String `tfsdk:"leaderboard_set_id"`
+	Locale           types.String `tfsdk:"locale"`
+	Name             types.String `tfsdk:"name"`
+}
+
+// leaderboardSetLocalizationAttributes maps App Store Connect attributes and relationships of the leaderboard set localization to the schema attributes.
+var leaderboardSetLocalizationAttributes = map[string]string{
+	"gameCenterLeaderboardSet": "leaderboard_set_id",
+	"locale":                   "locale",
+	"name":                     "name",
+}
+
+type leaderboardSetLocalizationResource struct {
+	client *connect.Client
+}
+
+func NewLeaderboardSetLocalizationResource() resource.Resource {
+	return &leaderboardSetLocalizationResource{}
+}
+
+func (r *leaderboardSetLocalizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leaderboard_set_localization"
+}
+
+func (r *leaderboardSetLocalizationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*connect.Client)
+}
+
+func (r *leaderboardSetLocalizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages game center leaderboard set localization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the leaderboard set localization.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"leaderboard_set_id": schema.StringAttribute{
+				Description: "Identifier of the leaderboard set to associate the localization with. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"locale": schema.StringAttribute{
+				Description: "Locale of the leaderboard set localization. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the leaderboard set.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *leaderboardSetLocalizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	state := leaderboardSetLocalizationResourceModel{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setID := state.LeaderboardSetID.ValueString()
+	if setID == "" {
+		resp.Diagnostics.AddError(
+			"Missing required attribute",
+			"Attribute 'leaderboard_set_id' is required to create a leaderboard set localization.",
+		)
+		return
+	}
+
+	set, err := r.client.GetLeaderboardSetByID(ctx, setID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read leaderboard set", err, nil)
+		return
+	}
+
+	localization, err := r.client.CreateLeaderboardSetLocalization(ctx, set.ID, &connect.LeaderboardSetLocalization{
+		Locale: state.Locale.ValueString(),
+		Name:   state.Name.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to create leaderboard set localization", err, leaderboardSetLocalizationAttributes)
+		return
+	}
+
+	state.ID = types.StringValue(localization.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardSetLocalizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := leaderboardSetLocalizationResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localization, err := r.client.GetLeaderboardSetLocalizationByID(ctx, state.ID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Leaderboard set localization not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read leaderboard set localization", err, leaderboardSetLocalizationAttributes)
+		return
+	}
+
+	state.LeaderboardSetID = types.StringValue(localization.RelatedID("gameCenterLeaderboardSet"))
+	state.Locale = types.StringValue(localization.Attr.Locale)
+	state.Name = types.StringValue(localization.Attr.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardSetLocalizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := leaderboardSetLocalizationResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateLeaderboardSetLocalization(ctx, connect.LeaderboardSetLocalizationUpdate{
+		ID:   plan.ID.ValueString(),
+		Name: plan.Name.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to update leaderboard set localization", err, leaderboardSetLocalizationAttributes)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *leaderboardSetLocalizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := leaderboardSetLocalizationResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteLeaderboardSetLocalizationByID(ctx, state.ID.ValueString())
+	if err != nil && !connect.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Failed to delete leaderboard set localization", err, leaderboardSetLocalizationAttributes)
+		return
+	}
+}
+
+// ImportState accepts either the localization identifier or "<leaderboard set id>/<locale>".
+func (r *leaderboardSetLocalizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importLocalizationState(ctx, req, resp, r.client.ListLeaderboardSetLocalizations, func(l connect.LeaderboardSetLocalization) string {
+		return l.Locale
+	})
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLeaderboardSetLocalizationResource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetLocalizationConfig(gameCenterID, "Season One"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_leaderboard_set_localization.test", &id),
+					resource.TestCheckResourceAttrPair("appstore_leaderboard_set_localization.test", "leaderboard_set_id", "appstore_leaderboard_set.test", "id"),
+					resource.TestCheckResourceAttr("appstore_leaderboard_set_localization.test", "locale", "en-US"),
+					resource.TestCheckResourceAttr("appstore_leaderboard_set_localization.test", "name", "Season One"),
+				),
+			},
+			{
+				ResourceName:      "appstore_leaderboard_set_localization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName: "appstore_leaderboard_set_localization.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["appstore_leaderboard_set.test"].Primary.ID + "/en-US", nil
+				},
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetLocalizationConfig(gameCenterID, "Season Two"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_set_localization.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_leaderboard_set_localization.test", "name", "Season Two"),
+			},
+			{
+				PreConfig: func() {
+					srv.Update("gameCenterLeaderboardSetLocalizations", id, map[string]any{"name": "Changed"})
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetLocalizationConfig(gameCenterID, "Season Two"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_set_localization.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_leaderboard_set_localization.test", "name", "Season Two"),
+			},
+			{
+				PreConfig: func() {
+					srv.Remove("gameCenterLeaderboardSetLocalizations", id)
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetLocalizationConfig(gameCenterID, "Season Two"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_set_localization.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccLeaderboardSetLocalizationConfig(gameCenterID, name string) string {
+	return testAccLeaderboardSetConfig(gameCenterID, "Season One") + fmt.Sprintf(`
+resource "appstore_leaderboard_set_localization" "test" {
+  leaderboard_set_id = appstore_leaderboard_set.test.id
+  locale             = "en-US"
+  name               = %q
+}
+`, name)
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &leaderboardSetMembersResource{}
+	_ resource.ResourceWithConfigure   = &leaderboardSetMembersResource{}
+	_ resource.ResourceWithImportState = &leaderboardSetMembersResource{}
+)
+
+type leaderboardSetMembersResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	LeaderboardSetID types.String   `tfsdk:"leaderboard_set_id"`
+	LeaderboardIDs   []types.String `tfsdk:"leaderboard_ids"`
+}
+
+type leaderboardSetMembersResource struct {
+	client *connect.Client
+}
+
+func NewLeaderboardSetMembersResource() resource.Resource {
+	return &leaderboardSetMembersResource{}
+}
+
+func (r *leaderboardSetMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leaderboard_set_members"
+}
+
+func (r *leaderboardSetMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*connect.Client)
+}
+
+func (r *leaderboardSetMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the ordered list of leaderboards in a game center leaderboard set. " +
+			"The resource owns the whole membership of the set, leaderboards not listed here are removed from the set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the leaderboard set members, same as the leaderboard set identifier.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"leaderboard_set_id": schema.StringAttribute{
+				Description: "Identifier of the leaderboard set. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"leaderboard_ids": schema.ListAttribute{
+				Description: "Identifiers of the leaderboards in the set, in the order they appear to players.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *leaderboardSetMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	state := leaderboardSetMembersResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setID := state.LeaderboardSetID.ValueString()
+	if setID == "" {
+		resp.Diagnostics.AddError(
+			"Missing required attribute",
+			"Attribute 'leaderboard_set_id' is required to manage leaderboard set members.",
+		)
+		return
+	}
+
+	err := r.client.ReplaceLeaderboardSetMembers(ctx, setID, stringValues(state.LeaderboardIDs))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to update leaderboard set members", err, nil)
+		return
+	}
+
+	state.ID = types.StringValue(setID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardSetMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := leaderboardSetMembersResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, err := r.client.GetLeaderboardSetMembers(ctx, state.LeaderboardSetID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Leaderboard set not found, removing its members from state", map[string]interface{}{"id": state.LeaderboardSetID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read leaderboard set members", err, nil)
+		return
+	}
+
+	state.LeaderboardIDs = stringsValue(ids)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardSetMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := leaderboardSetMembersResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ReplaceLeaderboardSetMembers(ctx, plan.LeaderboardSetID.ValueString(), stringValues(plan.LeaderboardIDs))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to update leaderboard set members", err, nil)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *leaderboardSetMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := leaderboardSetMembersResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ReplaceLeaderboardSetMembers(ctx, state.LeaderboardSetID.ValueString(), nil)
+	if err != nil && !connect.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Failed to remove leaderboard set members", err, nil)
+		return
+	}
+}
+
+// ImportState accepts the leaderboard set identifier.
+func (r *leaderboardSetMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("leaderboard_set_id"), req.ID)...)
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/fakeasc"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLeaderboardSetMembersResource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	var setID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetMembersConfig(gameCenterID, "daily", "weekly"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_leaderboard_set.test", &setID),
+					resource.TestCheckResourceAttrPair("appstore_leaderboard_set_members.test", "id", "appstore_leaderboard_set.test", "id"),
+					resource.TestCheckResourceAttrPair("appstore_leaderboard_set_members.test", "leaderboard_set_id", "appstore_leaderboard_set.test", "id"),
+					resource.TestCheckResourceAttr("appstore_leaderboard_set_members.test", "leaderboard_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("appstore_leaderboard_set_members.test", "leaderboard_ids.0", "appstore_leaderboard.daily", "id"),
+					resource.TestCheckResourceAttrPair("appstore_leaderboard_set_members.test", "leaderboard_ids.1", "appstore_leaderboard.weekly", "id"),
+				),
+			},
+			{
+				ResourceName:      "appstore_leaderboard_set_members.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Members are reordered.
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetMembersConfig(gameCenterID, "weekly", "daily"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_set_members.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("appstore_leaderboard_set_members.test", "leaderboard_ids.0", "appstore_leaderboard.weekly", "id"),
+					resource.TestCheckResourceAttrPair("appstore_leaderboard_set_members.test", "leaderboard_ids.1", "appstore_leaderboard.daily", "id"),
+					testAccCheckLeaderboardSetMembers(srv, &setID, "weekly", "daily"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetMembersConfig(gameCenterID, "daily"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("appstore_leaderboard_set_members.test", "leaderboard_ids.#", "1"),
+					testAccCheckLeaderboardSetMembers(srv, &setID, "daily"),
+				),
+			},
+			{
+				// Leaderboards added to the set outside of Terraform are removed.
+				PreConfig: func() {
+					monthly := srv.Add("gameCenterLeaderboards", map[string]any{
+						"referenceName":    "Monthly",
+						"vendorIdentifier": "com.example.test.monthly",
+					}, map[string]string{"gameCenterDetail": gameCenterID})
+
+					obj, _ := srv.Get("gameCenterLeaderboardSets", setID)
+					srv.SetRelationship("gameCenterLeaderboardSets", setID, "gameCenterLeaderboards", append(obj.Relationships["gameCenterLeaderboards"], monthly))
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetMembersConfig(gameCenterID, "daily"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_set_members.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckLeaderboardSetMembers(srv, &setID, "daily"),
+			},
+			{
+				// Destroying the resource empties the set, but keeps the set and leaderboards.
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetMembersLeaderboardsConfig(gameCenterID),
+				Check:  testAccCheckLeaderboardSetMembers(srv, &setID),
+			},
+		},
+	})
+}
+
+// testAccCheckLeaderboardSetMembers verifies leaderboards of the set by their vendor identifier suffix.
+func testAccCheckLeaderboardSetMembers(srv *fakeasc.Server, setID *string, names ...string) func(*terraform.State) error {
+	return func(_ *terraform.State) error {
+		set, ok := srv.Get("gameCenterLeaderboardSets", *setID)
+		if !ok {
+			return fmt.Errorf("leaderboard set %s not found", *setID)
+		}
+
+		got := []string{}
+		for _, id := range set.Relationships["gameCenterLeaderboards"] {
+			leaderboard, _ := srv.Get("gameCenterLeaderboards", id)
+			vendorID, _ := leaderboard.Attributes["vendorIdentifier"].(string)
+			got = append(got, strings.TrimPrefix(vendorID, "com.example.test."))
+		}
+
+		if strings.Join(got, ",") != strings.Join(names, ",") {
+			return fmt.Errorf("leaderboard set %s has members %v, want %v", *setID, got, names)
+		}
+
+		return nil
+	}
+}
+
+func testAccLeaderboardSetMembersConfig(gameCenterID string, members ...string) string {
+	refs := make([]string, 0, len(members))
+	for _, m := range members {
+		refs = append(refs, "appstore_leaderboard."+m+".id")
+	}
+
+	return testAccLeaderboardSetMembersLeaderboardsConfig(gameCenterID) + fmt.Sprintf(`
+resource "appstore_leaderboard_set_members" "test" {
+  leaderboard_set_id = appstore_leaderboard_set.test.id
+  leaderboard_ids    = [%s]
+}
+`, strings.Join(refs, ", "))
+}
+
+func testAccLeaderboardSetMembersLeaderboardsConfig(gameCenterID string) string {
+	return testAccLeaderboardSetConfig(gameCenterID, "Season One") + fmt.Sprintf(`
+resource "appstore_leaderboard" "daily" {
+  game_center_id  = %[1]q
+  reference_name  = "Daily"
+  vendor_id       = "com.example.test.daily"
+  score_format    = "INTEGER"
+  sort_order      = "DESC"
+  submission_type = "BEST_SCORE"
+}
+
+resource "appstore_leaderboard" "weekly" {
+  game_center_id  = %[1]q
+  reference_name  = "Weekly"
+  vendor_id       = "com.example.test.weekly"
+  score_format    = "INTEGER"
+  sort_order      = "DESC"
+  submission_type = "BEST_SCORE"
+}
+`, gameCenterID)
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = &leaderboardSetResource{}
+	_ resource.ResourceWithConfigure        = &leaderboardSetResource{}
+	_ resource.ResourceWithImportState      = &leaderboardSetResource{}
+	_ resource.ResourceWithConfigValidators = &leaderboardSetResource{}
+)
+
+type leaderboardSetResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	GameCenterID      types.String `tfsdk:"game_center_id"`
+	GameCenterGroupID types.String `tfsdk:"game_center_group_id"`
+	ReferenceName     types.String `tfsdk:"reference_name"`
+	VendorID          types.String `tfsdk:"vendor_id"`
+}
+
+// leaderboardSetAttributes maps App Store Connect attributes and relationships of the leaderboard set to the schema attributes.
+var leaderboardSetAttributes = map[string]string{
+	"gameCenterDetail": "game_center_id",
+	"gameCenterGroup":  "game_center_group_id",
+	"referenceName":    "reference_name",
+	"vendorIdentifier": "vendor_id",
+}
+
+type leaderboardSetResource struct {
+	client *connect.Client
+}
+
+func NewLeaderboardSetResource() resource.Resource {
+	return &leaderboardSetResource{}
+}
+
+func (r *leaderboardSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leaderboard_set"
+}
+
+func (r *leaderboardSetResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*connect.Client)
+}
+
+func (r *leaderboardSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages game center leaderboard set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the leaderboard set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"game_center_id": schema.StringAttribute{
+				Description: "Identifier of the game center to associate the leaderboard set with. Conflicts with game_center_group_id. " +
+					"Resource will be re-created if this value is changed.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"game_center_group_id": schema.StringAttribute{
+				Description: "Identifier of the game center group to associate the leaderboard set with, sharing it among the apps of the group. " +
+					"Conflicts with game_center_id. Resource will be re-created if this value is changed.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reference_name": schema.StringAttribute{
+				Description: "An internal name of the leaderboard set.",
+				Required:    true,
+			},
+			"vendor_id": schema.StringAttribute{
+				Description: "A chosen alphanumeric identifier of the leaderboard set. Resource will be re-created if this value is changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *leaderboardSetResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return ownerConfigValidators()
+}
+
+func (r *leaderboardSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	state := leaderboardSetResourceModel{}
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner, ok := readOwner(ctx, r.client, state.GameCenterID, state.GameCenterGroupID, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	set, err := r.client.CreateLeaderboardSet(ctx, owner, &connect.LeaderboardSet{
+		ReferenceName:    state.ReferenceName.ValueString(),
+		VendorIdentifier: state.VendorID.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to create leaderboard set", err, leaderboardSetAttributes)
+		return
+	}
+
+	state.ID = types.StringValue(set.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := leaderboardSetResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set, err := r.client.GetLeaderboardSetByID(ctx, state.ID.ValueString())
+	if connect.IsNotFound(err) {
+		tflog.Warn(ctx, "Leaderboard set not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to read leaderboard set", err, leaderboardSetAttributes)
+		return
+	}
+
+	state.GameCenterID, state.GameCenterGroupID = ownerValues(set)
+	state.ReferenceName = types.StringValue(set.Attr.ReferenceName)
+	state.VendorID = types.StringValue(set.Attr.VendorIdentifier)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *leaderboardSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := leaderboardSetResourceModel{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateLeaderboardSet(ctx, connect.LeaderboardSetUpdate{
+		ID:            plan.ID.ValueString(),
+		ReferenceName: plan.ReferenceName.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Failed to update leaderboard set", err, leaderboardSetAttributes)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *leaderboardSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := leaderboardSetResourceModel{}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteLeaderboardSetByID(ctx, state.ID.ValueString())
+	if err != nil && !connect.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "Failed to delete leaderboard set", err, leaderboardSetAttributes)
+		return
+	}
+}
+
+func (r *leaderboardSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccLeaderboardSetResource(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetConfig(gameCenterID, "Season One"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccResourceID("appstore_leaderboard_set.test", &id),
+					resource.TestCheckResourceAttr("appstore_leaderboard_set.test", "game_center_id", gameCenterID),
+					resource.TestCheckResourceAttr("appstore_leaderboard_set.test", "reference_name", "Season One"),
+					resource.TestCheckResourceAttr("appstore_leaderboard_set.test", "vendor_id", "com.example.test.season"),
+				),
+			},
+			{
+				ResourceName:      "appstore_leaderboard_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetConfig(gameCenterID, "Season Two"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_set.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_leaderboard_set.test", "reference_name", "Season Two"),
+			},
+			{
+				PreConfig: func() {
+					srv.Update("gameCenterLeaderboardSets", id, map[string]any{"referenceName": "Changed"})
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetConfig(gameCenterID, "Season Two"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_set.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("appstore_leaderboard_set.test", "reference_name", "Season Two"),
+			},
+			{
+				PreConfig: func() {
+					srv.Remove("gameCenterLeaderboardSets", id)
+				},
+				Config: testAccProviderConfig(srv) + testAccLeaderboardSetConfig(gameCenterID, "Season Two"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("appstore_leaderboard_set.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccLeaderboardSetResource_group(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	config := testAccProviderConfig(srv) + testAccGameCenterGroupConfig("Shared", gameCenterID) +
+		strings.Replace(testAccLeaderboardSetConfig("", "Season One"),
+			`game_center_id = ""`, `game_center_group_id = appstore_game_center_group.test.id`, 1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("appstore_leaderboard_set.test", "game_center_group_id", "appstore_game_center_group.test", "id"),
+					resource.TestCheckNoResourceAttr("appstore_leaderboard_set.test", "game_center_id"),
+				),
+			},
+			{
+				ResourceName:      "appstore_leaderboard_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLeaderboardSetConfig(gameCenterID, name string) string {
+	return fmt.Sprintf(`
+resource "appstore_leaderboard_set" "test" {
+  game_center_id = %q
+  reference_name = %q
+  vendor_id      = "com.example.test.season"
+}
+`, gameCenterID, name)
+}
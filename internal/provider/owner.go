@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ownerConfigValidators requires exactly one of the owner attributes.
+func ownerConfigValidators() []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("game_center_id"),
+			path.MatchRoot("game_center_group_id"),
+		),
+	}
+}
+
+// readOwner checks that the game center or the game center group exists and returns it as the owner of a new resource.
+func readOwner(ctx context.Context, client *connect.Client, gameCenterID, groupID types.String, diags *diag.Diagnostics) (connect.Owner, bool) {
+	if !groupID.IsNull() {
+		group, err := client.GetGameCenterGroupByID(ctx, groupID.ValueString())
+		if err != nil {
+			addClientError(diags, "Failed to read game center group", err, nil)
+			return connect.Owner{}, false
+		}
+
+		return connect.Owner{GroupID: group.ID}, true
+	}
+
+	gameCenter, err := client.GetGameCenterByID(ctx, gameCenterID.ValueString())
+	if err != nil {
+		addClientError(diags, "Failed to read game center", err, nil)
+		return connect.Owner{}, false
+	}
+
+	return connect.Owner{GameCenterID: gameCenter.ID}, true
+}
+
+// ownerValues returns the owner attributes of a resource read from App Store Connect, the attribute of the other
+// owner kind is null.
+func ownerValues[T any](r *connect.Resource[T]) (gameCenterID, groupID types.String) {
+	gameCenterID, groupID = types.StringNull(), types.StringNull()
+
+	if id := r.RelatedID("gameCenterGroup"); id != "" {
+		groupID = types.StringValue(id)
+	}
+	if id := r.RelatedID("gameCenterDetail"); id != "" {
+		gameCenterID = types.StringValue(id)
+	}
+
+	return gameCenterID, groupID
+}
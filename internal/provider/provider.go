@@ -2,12 +2,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"regexp"
 	"time"
 
-	"github.com/alexprogrammr/appstore-go"
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -17,7 +23,8 @@ import (
 )
 
 var (
-	_ provider.Provider = &appstoreProvider{}
+	_ provider.Provider                     = &appstoreProvider{}
+	_ provider.ProviderWithConfigValidators = &appstoreProvider{}
 )
 
 type appstoreProvider struct {
@@ -25,11 +32,37 @@ type appstoreProvider struct {
 }
 
 type appstoreProviderModel struct {
-	KeyID      types.String `tfsdk:"key_id"`
-	IssuerID   types.String `tfsdk:"issuer_id"`
-	PrivateKey types.String `tfsdk:"private_key"`
+	KeyID                 types.String `tfsdk:"key_id"`
+	IssuerID              types.String `tfsdk:"issuer_id"`
+	PrivateKey            types.String `tfsdk:"private_key"`
+	PrivateKeyPath        types.String `tfsdk:"private_key_path"`
+	Endpoint              types.String `tfsdk:"endpoint"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin          types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax          types.String `tfsdk:"retry_wait_max"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	RequestTimeout        types.String `tfsdk:"request_timeout"`
+	ProxyURL              types.String `tfsdk:"proxy_url"`
+	CACertPEM             types.String `tfsdk:"ca_cert_pem"`
+	CACertPath            types.String `tfsdk:"ca_cert_path"`
+	TokenLifetime         types.String `tfsdk:"token_lifetime"`
+	Scope                 types.List   `tfsdk:"scope"`
+	ValidateCredentials   types.Bool   `tfsdk:"validate_credentials"`
 }
 
+const (
+	defaultMaxRetries   = 5
+	defaultRetryWaitMin = time.Second
+	defaultRetryWaitMax = 30 * time.Second
+
+	defaultTokenLifetime = 10 * time.Minute
+)
+
+var (
+	keyIDPattern    = regexp.MustCompile(`^[A-Z0-9]{10}$`)
+	issuerIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &appstoreProvider{
@@ -48,24 +81,107 @@ func (p *appstoreProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 		Description: "Interact with App Store Connect.",
 		Attributes: map[string]schema.Attribute{
 			"key_id": schema.StringAttribute{
-				Description: "Private key ID from App Store Connect, for example, 2X9R4HXF34.",
-				Required:    true,
+				Description: "Private key ID from App Store Connect, for example, 2X9R4HXF34. " +
+					"May also be provided via the APPSTORE_KEY_ID environment variable. " +
+					"The unprefixed KEY_ID environment variable is deprecated and will be removed in the next major version.",
+				Optional: true,
 			},
 			"issuer_id": schema.StringAttribute{
-				Description: "Issuer ID from the API Keys page in App Store Connect, for example, 57246542-96fe-1a63-e053-0824d011072a.",
-				Required:    true,
+				Description: "Issuer ID from the API Keys page in App Store Connect, for example, 57246542-96fe-1a63-e053-0824d011072a. " +
+					"May also be provided via the APPSTORE_ISSUER_ID environment variable. " +
+					"The unprefixed ISSUER_ID environment variable is deprecated and will be removed in the next major version.",
+				Optional: true,
 			},
 			"private_key": schema.StringAttribute{
 				Description: "PEM-encoded private key from App Store Connect. " +
 					"Keep your API keys secure and private. Don’t share your keys, store keys in a code repository, or include keys in client-side code. " +
-					"If the key becomes lost or compromised, remember to revoke it immediately.",
-				Required:  true,
+					"If the key becomes lost or compromised, remember to revoke it immediately. " +
+					"May also be provided via the APPSTORE_PRIVATE_KEY environment variable. Conflicts with private_key_path. " +
+					"The unprefixed PRIVATE_KEY environment variable is deprecated and will be removed in the next major version.",
+				Optional:  true,
 				Sensitive: true,
 			},
+			"private_key_path": schema.StringAttribute{
+				Description: "Path to the PEM-encoded private key file downloaded from App Store Connect, for example, AuthKey_2X9R4HXF34.p8. " +
+					"May also be provided via the APPSTORE_PRIVATE_KEY_PATH environment variable. Conflicts with private_key.",
+				Optional: true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "Base URL of the App Store Connect API, for example, a recording proxy or a local fake. " +
+					"May also be provided via the APPSTORE_ENDPOINT environment variable. Defaults to " + connect.DefaultEndpoint + ".",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a request is retried when App Store Connect is rate limiting or temporarily unavailable. " +
+					"A request is not retried once the hourly request quota is exhausted. Set to 0 to disable retries. Defaults to 5.",
+				Optional: true,
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Description: "Minimum time to wait before retrying a request, for example, 500ms. " +
+					"The wait doubles with every attempt, unless App Store Connect specifies it with the Retry-After header. Defaults to 1s.",
+				Optional: true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Description: "Maximum time to wait before retrying a request, for example, 1m. " +
+					"A request App Store Connect asks to retry later than that with the Retry-After header fails without retrying. Defaults to 30s.",
+				Optional: true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests sent to App Store Connect at the same time, shared by all resources and data sources. " +
+					"Other requests wait in a queue. Requests are not limited by default.",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Time limit for a single attempt of a request, including upload of images, for example, 30s. Defaults to 5m.",
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy to send requests through, for example, http://proxy.example.com:3128. " +
+					"Defaults to the proxy from the HTTPS_PROXY and NO_PROXY environment variables.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded certificate authorities to trust in addition to the system ones, for example, of a TLS-intercepting proxy. " +
+					"Conflicts with ca_cert_path.",
+				Optional: true,
+			},
+			"ca_cert_path": schema.StringAttribute{
+				Description: "Path to the PEM-encoded certificate authorities to trust in addition to the system ones. Conflicts with ca_cert_pem.",
+				Optional:    true,
+			},
+			"token_lifetime": schema.StringAttribute{
+				Description: "Lifetime of the signed API tokens, for example, 5m. The token is reused until the last quarter of its lifetime. " +
+					"Must be between 1m and 20m. Defaults to 10m.",
+				Optional: true,
+			},
+			"scope": schema.ListAttribute{
+				Description: "Operations the API tokens are limited to, for example, \"GET /v1/apps\". " +
+					"Requests outside of the scope are rejected by App Store Connect. Tokens are not limited by default.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"validate_credentials": schema.BoolAttribute{
+				Description: "Whether to check the credentials with a request reading a single app when the provider is configured, " +
+					"so that a revoked key or a mismatched key ID or issuer ID fails early. The request must be allowed by the scope. Defaults to false.",
+				Optional: true,
+			},
 		},
 	}
 }
 
+func (p *appstoreProvider) ConfigValidators(_ context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(
+			path.MatchRoot("private_key"),
+			path.MatchRoot("private_key_path"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("ca_cert_pem"),
+			path.MatchRoot("ca_cert_path"),
+		),
+	}
+}
+
 func (p *appstoreProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring App Store Connect API client")
 
@@ -82,7 +198,7 @@ func (p *appstoreProvider) Configure(ctx context.Context, req provider.Configure
 			path.Root("key_id"),
 			"Unknown Key ID",
 			"The provider cannot create the App Store Connect API client as there is an unknown configuration value for the Key ID. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the KEY_ID environment variable.",
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the APPSTORE_KEY_ID environment variable.",
 		)
 	}
 	if config.IssuerID.IsUnknown() {
@@ -90,7 +206,7 @@ func (p *appstoreProvider) Configure(ctx context.Context, req provider.Configure
 			path.Root("issuer_id"),
 			"Unknown Issuer ID",
 			"The provider cannot create the App Store Connect API client as there is an unknown configuration value for the Issuer ID. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the ISSUER_ID environment variable.",
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the APPSTORE_ISSUER_ID environment variable.",
 		)
 	}
 	if config.PrivateKey.IsUnknown() {
@@ -98,17 +214,63 @@ func (p *appstoreProvider) Configure(ctx context.Context, req provider.Configure
 			path.Root("private_key"),
 			"Unknown Private Key",
 			"The provider cannot create the App Store Connect API client as there is an unknown configuration value for the Private Key. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the PRIVATE_KEY environment variable.",
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the APPSTORE_PRIVATE_KEY environment variable.",
+		)
+	}
+	if config.PrivateKeyPath.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_key_path"),
+			"Unknown Private Key Path",
+			"The provider cannot create the App Store Connect API client as there is an unknown configuration value for the Private Key Path. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the APPSTORE_PRIVATE_KEY_PATH environment variable.",
+		)
+	}
+
+	if config.Endpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Unknown Endpoint",
+			"The provider cannot create the App Store Connect API client as there is an unknown configuration value for the Endpoint. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the APPSTORE_ENDPOINT environment variable.",
 		)
 	}
 
+	// The client settings have no environment variables to fall back to.
+	for _, setting := range []struct {
+		name  string
+		title string
+		value attr.Value
+	}{
+		{name: "max_retries", title: "Max Retries", value: config.MaxRetries},
+		{name: "retry_wait_min", title: "Retry Wait Min", value: config.RetryWaitMin},
+		{name: "retry_wait_max", title: "Retry Wait Max", value: config.RetryWaitMax},
+		{name: "max_concurrent_requests", title: "Max Concurrent Requests", value: config.MaxConcurrentRequests},
+		{name: "request_timeout", title: "Request Timeout", value: config.RequestTimeout},
+		{name: "proxy_url", title: "Proxy URL", value: config.ProxyURL},
+		{name: "ca_cert_pem", title: "CA Certificate", value: config.CACertPEM},
+		{name: "ca_cert_path", title: "CA Certificate Path", value: config.CACertPath},
+		{name: "token_lifetime", title: "Token Lifetime", value: config.TokenLifetime},
+		{name: "scope", title: "Scope", value: config.Scope},
+		{name: "validate_credentials", title: "Validate Credentials", value: config.ValidateCredentials},
+	} {
+		if setting.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(setting.name),
+				"Unknown "+setting.title,
+				"The provider cannot create the App Store Connect API client as there is an unknown configuration value for the "+setting.title+". "+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	keyID := os.Getenv("KEY_ID")
-	issuerID := os.Getenv("ISSUER_ID")
-	privateKey := os.Getenv("PRIVATE_KEY")
+	keyID := getenv("APPSTORE_KEY_ID", "KEY_ID", &resp.Diagnostics)
+	issuerID := getenv("APPSTORE_ISSUER_ID", "ISSUER_ID", &resp.Diagnostics)
+	privateKey := getenv("APPSTORE_PRIVATE_KEY", "PRIVATE_KEY", &resp.Diagnostics)
+	privateKeyPath := os.Getenv("APPSTORE_PRIVATE_KEY_PATH")
 
 	if !config.KeyID.IsNull() {
 		keyID = config.KeyID.ValueString()
@@ -116,8 +278,34 @@ func (p *appstoreProvider) Configure(ctx context.Context, req provider.Configure
 	if !config.IssuerID.IsNull() {
 		issuerID = config.IssuerID.ValueString()
 	}
-	if !config.PrivateKey.IsNull() {
+
+	// Inline key and key file are mutually exclusive in the configuration, the configuration takes precedence over
+	// the environment and the inline key takes precedence over the key file within the environment.
+	privateKeyAttr := path.Root("private_key")
+	switch {
+	case !config.PrivateKey.IsNull():
 		privateKey = config.PrivateKey.ValueString()
+	case !config.PrivateKeyPath.IsNull():
+		privateKey = ""
+		privateKeyPath = config.PrivateKeyPath.ValueString()
+	}
+
+	if privateKey == "" && privateKeyPath != "" {
+		privateKeyAttr = path.Root("private_key_path")
+
+		data, err := os.ReadFile(privateKeyPath)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				privateKeyAttr,
+				"Unreadable Private Key File",
+				"The provider cannot create the App Store Connect API client as the private key file cannot be read. "+
+					"Ensure the path in the configuration or in the APPSTORE_PRIVATE_KEY_PATH environment variable is correct.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+
+		privateKey = string(data)
 	}
 
 	if keyID == "" {
@@ -125,7 +313,7 @@ func (p *appstoreProvider) Configure(ctx context.Context, req provider.Configure
 			path.Root("key_id"),
 			"Missing Key ID",
 			"The provider cannot create the App Store Connect API client as there is a missing or empty value for the Key ID. "+
-				"Set the host value in the configuration or use the KEY_ID environment variable. "+
+				"Set the value in the configuration or use the APPSTORE_KEY_ID environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -134,16 +322,16 @@ func (p *appstoreProvider) Configure(ctx context.Context, req provider.Configure
 			path.Root("issuer_id"),
 			"Missing Issuer ID",
 			"The provider cannot create the App Store Connect API client as there is a missing or empty value for the Issuer ID. "+
-				"Set the host value in the configuration or use the ISSUER_ID environment variable. "+
+				"Set the value in the configuration or use the APPSTORE_ISSUER_ID environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 	if privateKey == "" {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("private_key"),
+			privateKeyAttr,
 			"Missing Private Key",
 			"The provider cannot create the App Store Connect API client as there is a missing or empty value for the Private Key. "+
-				"Set the host value in the configuration or use the PRIVATE_KEY environment variable. "+
+				"Set private_key or private_key_path in the configuration, or use the APPSTORE_PRIVATE_KEY or APPSTORE_PRIVATE_KEY_PATH environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -152,18 +340,105 @@ func (p *appstoreProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	if !keyIDPattern.MatchString(keyID) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("key_id"),
+			"Invalid Key ID",
+			"The Key ID must consist of 10 uppercase letters and digits, for example, 2X9R4HXF34. "+
+				"Copy the Key ID of the API key from the Users and Access section of App Store Connect.",
+		)
+	}
+	if !issuerIDPattern.MatchString(issuerID) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("issuer_id"),
+			"Invalid Issuer ID",
+			"The Issuer ID must be a UUID, for example, 57246542-96fe-1a63-e053-0824d011072a. "+
+				"Copy the Issuer ID from the Users and Access section of App Store Connect.",
+		)
+	}
+	if _, err := connect.ParsePrivateKey([]byte(privateKey)); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			privateKeyAttr,
+			"Invalid Private Key",
+			"The provider cannot create the App Store Connect API client as the private key is not a PEM-encoded P-256 ECDSA key, "+
+				"the format of the .p8 file downloaded from App Store Connect.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := os.Getenv("APPSTORE_ENDPOINT")
+	if !config.Endpoint.IsNull() {
+		endpoint = config.Endpoint.ValueString()
+	}
+
+	if endpoint != "" {
+		if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint"),
+				"Invalid Endpoint",
+				"The provider cannot create the App Store Connect API client as the Endpoint is not an absolute http or https URL, "+
+					"for example, http://localhost:8080. Check the value in the configuration or in the APPSTORE_ENDPOINT environment variable.",
+			)
+			return
+		}
+	}
+
+	retryPolicy := retryPolicy(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transport := httpTransport(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenLifetime := defaultTokenLifetime
+	if !config.TokenLifetime.IsNull() {
+		lifetime, err := time.ParseDuration(config.TokenLifetime.ValueString())
+		if err != nil || lifetime < time.Minute || lifetime > connect.MaxTokenLifetime {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_lifetime"),
+				"Invalid Token Lifetime",
+				fmt.Sprintf("The token lifetime must be a duration between 1m and %s, for example, 5m.", connect.MaxTokenLifetime),
+			)
+			return
+		}
+		tokenLifetime = lifetime
+	}
+
+	var scope []string
+	resp.Diagnostics.Append(config.Scope.ElementsAs(ctx, &scope, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxConcurrentRequests := int(config.MaxConcurrentRequests.ValueInt64())
+	if maxConcurrentRequests < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid Max Concurrent Requests",
+			"The maximum number of concurrent requests must not be negative.",
+		)
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "endpoint", endpoint)
 	ctx = tflog.SetField(ctx, "key_id", keyID)
 	ctx = tflog.SetField(ctx, "issuer_id", issuerID)
-	ctx = tflog.SetField(ctx, "private_key", privateKey)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "private_key")
 
 	tflog.Debug(ctx, "Creating App Store Connect API client")
 
-	source, err := appstore.NewTokenSource(appstore.Config{
-		KeyID:       keyID,
-		IssuerID:    issuerID,
-		PrivateKey:  []byte(privateKey),
-		ExpireAfter: time.Minute,
+	source, err := connect.NewTokenSource(connect.TokenConfig{
+		KeyID:      keyID,
+		IssuerID:   issuerID,
+		PrivateKey: []byte(privateKey),
+		Lifetime:   tokenLifetime,
+		Scope:      scope,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -175,7 +450,56 @@ func (p *appstoreProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	client := appstore.NewClient(http.DefaultClient, source)
+	// Every attempt is logged separately, without the time spent in the queue of the limiter.
+	transport = connect.NewLoggingTransport(transport)
+
+	// Every attempt is signed when it is sent, so that a retry does not reuse a token that expired while waiting.
+	transport = connect.NewAuthTransport(transport, source)
+
+	// Retries wait outside of the limiter, so that a backing off request does not hold a slot.
+	if maxConcurrentRequests > 0 {
+		transport = connect.NewLimitTransport(transport, maxConcurrentRequests)
+	}
+
+	httpClient := &http.Client{
+		Transport: connect.NewRetryTransport(transport, retryPolicy),
+	}
+
+	client := connect.NewClient(httpClient, source)
+	if endpoint != "" {
+		client.SetEndpoint(endpoint)
+	}
+
+	if config.ValidateCredentials.ValueBool() {
+		err := client.VerifyCredentials(ctx)
+		switch {
+		case connect.IsUnauthorized(err):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("key_id"),
+				"Invalid Credentials",
+				fmt.Sprintf("App Store Connect rejected the token signed with the key %s for the issuer %s. ", keyID, issuerID)+
+					"Ensure the Key ID and Issuer ID belong to the private key and the key has not been revoked.\n\n"+
+					"App Store Connect Client Error: "+err.Error(),
+			)
+			return
+		case connect.IsForbidden(err):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("key_id"),
+				"Insufficient Key Permissions",
+				fmt.Sprintf("App Store Connect accepted the key %s, but the key is not allowed to read apps. ", keyID)+
+					"Ensure the key has a role with access to the apps, and the scope includes GET /v1/apps.\n\n"+
+					"App Store Connect Client Error: "+err.Error(),
+			)
+			return
+		case err != nil:
+			resp.Diagnostics.AddError(
+				"Failed to Verify Credentials",
+				"An unexpected error occurred when verifying the credentials with App Store Connect.\n\n"+
+					"App Store Connect Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -183,18 +507,103 @@ func (p *appstoreProvider) Configure(ctx context.Context, req provider.Configure
 	tflog.Info(ctx, "Configured App Store Connect API client")
 }
 
+// getenv returns the value of the environment variable, falling back to the deprecated unprefixed variable, which
+// is reported with a warning until it is removed in the next major version.
+func getenv(key, deprecated string, diags *diag.Diagnostics) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	value := os.Getenv(deprecated)
+	if value != "" {
+		diags.AddWarning(
+			"Deprecated Environment Variable",
+			"The "+deprecated+" environment variable is deprecated and will no longer be read in the next major version of the provider. "+
+				"Use the "+key+" environment variable instead.",
+		)
+	}
+
+	return value
+}
+
+func retryPolicy(config appstoreProviderModel, diags *diag.Diagnostics) connect.RetryPolicy {
+	policy := connect.RetryPolicy{
+		MaxRetries: defaultMaxRetries,
+		MinBackoff: defaultRetryWaitMin,
+		MaxBackoff: defaultRetryWaitMax,
+	}
+
+	if !config.MaxRetries.IsNull() {
+		policy.MaxRetries = int(config.MaxRetries.ValueInt64())
+		if policy.MaxRetries < 0 {
+			diags.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Max Retries",
+				"The maximum number of retries must not be negative.",
+			)
+		}
+	}
+
+	if !config.RetryWaitMin.IsNull() {
+		wait, err := time.ParseDuration(config.RetryWaitMin.ValueString())
+		if err != nil || wait <= 0 {
+			diags.AddAttributeError(
+				path.Root("retry_wait_min"),
+				"Invalid Retry Wait",
+				"The minimum retry wait must be a positive duration, for example, 500ms or 2s.",
+			)
+		}
+		policy.MinBackoff = wait
+	}
+
+	if !config.RetryWaitMax.IsNull() {
+		wait, err := time.ParseDuration(config.RetryWaitMax.ValueString())
+		if err != nil || wait <= 0 {
+			diags.AddAttributeError(
+				path.Root("retry_wait_max"),
+				"Invalid Retry Wait",
+				"The maximum retry wait must be a positive duration, for example, 30s or 1m.",
+			)
+		}
+		policy.MaxBackoff = wait
+	}
+
+	if !diags.HasError() && policy.MinBackoff > policy.MaxBackoff {
+		diags.AddAttributeError(
+			path.Root("retry_wait_max"),
+			"Invalid Retry Wait",
+			fmt.Sprintf("The maximum retry wait %s must not be less than the minimum retry wait %s.", policy.MaxBackoff, policy.MinBackoff),
+		)
+	}
+
+	return policy
+}
+
 func (p *appstoreProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAppsDataSource,
 		NewAppDataSource,
 		NewGameCenterDataSource,
+		NewAchievementsDataSource,
+		NewAchievementDataSource,
 	}
 }
 
 func (p *appstoreProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewGameCenterResource,
+		NewGameCenterGroupResource,
 		NewAchievementResource,
 		NewAchievementLocalizationResource,
 		NewAchievementImageResource,
+		NewAchievementReleaseResource,
+		NewAchievementOrderResource,
+		NewLeaderboardResource,
+		NewLeaderboardLocalizationResource,
+		NewLeaderboardImageResource,
+		NewLeaderboardSetResource,
+		NewLeaderboardSetMembersResource,
+		NewLeaderboardSetLocalizationResource,
+		NewLeaderboardSetImageResource,
 	}
 }
@@ -0,0 +1,543 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/fakeasc"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProtoV6ProviderFactories runs the provider in the test process, so
+// that it can reach the fake App Store Connect server started by the test.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"appstore": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+const (
+	testAccKeyID    = "2X9R4HXF34"
+	testAccIssuerID = "57246542-96fe-1a63-e053-0824d011072a"
+)
+
+// testAccPrivateKey is the key the provider signs tokens with and the fake server verifies them with.
+var testAccPrivateKey = func() []byte {
+	key, err := fakeasc.GenerateKey()
+	if err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+// testAccServer starts a fake App Store Connect server with a single app
+// and returns the server together with the game center of the app.
+func testAccServer(t *testing.T) (*fakeasc.Server, string) {
+	t.Helper()
+
+	srv, err := fakeasc.NewServer(fakeasc.Config{
+		KeyID:      testAccKeyID,
+		IssuerID:   testAccIssuerID,
+		PrivateKey: testAccPrivateKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Close)
+
+	_, gameCenterID := srv.AddApp("Test App", "com.example.test", "TESTAPP")
+
+	return srv, gameCenterID
+}
+
+// testAccProviderConfig returns the provider block pointing to the fake server.
+// Extra attributes are given one per argument, for example `proxy_url = "http://localhost:3128"`,
+// and replace the default attribute of the same name.
+func testAccProviderConfig(srv *fakeasc.Server, attributes ...string) string {
+	lines := []string{
+		fmt.Sprintf("endpoint = %q", srv.URL),
+		fmt.Sprintf("key_id = %q", testAccKeyID),
+		fmt.Sprintf("issuer_id = %q", testAccIssuerID),
+		testAccPrivateKeyAttribute(testAccPrivateKey),
+		"max_retries = 0",
+	}
+	for _, attribute := range attributes {
+		name := testAccAttributeName(attribute)
+		lines = slices.DeleteFunc(lines, func(line string) bool {
+			return testAccAttributeName(line) == name
+		})
+		lines = append(lines, attribute)
+	}
+
+	return "\nprovider \"appstore\" {\n  " + strings.Join(lines, "\n  ") + "\n}\n"
+}
+
+// testAccPrivateKeyAttribute returns the private_key attribute holding the key.
+func testAccPrivateKeyAttribute(key []byte) string {
+	return fmt.Sprintf("private_key = <<-EOT\n%sEOT", key)
+}
+
+// testAccAttributeName returns the name of the attribute set on the line.
+func testAccAttributeName(line string) string {
+	name, _, _ := strings.Cut(line, "=")
+	return strings.TrimSpace(name)
+}
+
+// testAccImageFile writes an image file to a temporary directory and returns its path.
+func testAccImageFile(t *testing.T, name string, data []byte) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(file, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return file
+}
+
+// testAccResourceID reads identifier of the resource from the state.
+func testAccResourceID(name string, id *string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccCheckUpload verifies the data uploaded to the fake server for the asset.
+func testAccCheckUpload(srv *fakeasc.Server, id *string, want string) func(*terraform.State) error {
+	return func(_ *terraform.State) error {
+		data, ok := srv.Upload(*id)
+		if !ok {
+			return fmt.Errorf("no data uploaded for asset %s", *id)
+		}
+		if string(data) != want {
+			return fmt.Errorf("uploaded data of asset %s is %q, want %q", *id, data, want)
+		}
+
+		return nil
+	}
+}
+
+func TestAccProvider_retry(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	// Creation is retried when rate limited, other failures are retried for reads only.
+	srv.InjectFault(fakeasc.Fault{
+		Method: http.MethodPost,
+		Path:   "/v1/gameCenterAchievements",
+		Status: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": []string{"0"}},
+		Times:  2,
+	})
+	srv.InjectFault(fakeasc.Fault{
+		Method: http.MethodGet,
+		Path:   "/v1/gameCenterAchievements",
+		Status: http.StatusServiceUnavailable,
+		Times:  2,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv, "max_retries = 2", `retry_wait_min = "1ms"`, `retry_wait_max = "10ms"`) + testAccAchievementConfig(gameCenterID, "First Win", 10),
+				Check:  resource.TestCheckResourceAttrSet("appstore_achievement.test", "id"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_invalidCredentials(t *testing.T) {
+	srv, _ := testAccServer(t)
+
+	otherKey, err := fakeasc.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(srv, testAccPrivateKeyAttribute(otherKey)) + `data "appstore_apps" "test" {}`,
+				ExpectError: regexp.MustCompile(`NOT_AUTHORIZED`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_customCA(t *testing.T) {
+	srv, _ := testAccServer(t)
+
+	target, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The fake server is put behind a TLS endpoint with a self-signed certificate.
+	front := httptest.NewTLSServer(httputil.NewSingleHostReverseProxy(target))
+	t.Cleanup(front.Close)
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: front.Certificate().Raw})
+	endpoint := fmt.Sprintf("endpoint = %q", front.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(srv, endpoint) + `data "appstore_apps" "test" {}`,
+				ExpectError: regexp.MustCompile(`certificate`),
+			},
+			{
+				Config: testAccProviderConfig(srv, endpoint, fmt.Sprintf("ca_cert_path = %q", testAccImageFile(t, "ca.pem", caCert))) + `data "appstore_apps" "test" {}`,
+				Check:  resource.TestCheckResourceAttr("data.appstore_apps.test", "apps.#", "1"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_proxy(t *testing.T) {
+	srv, _ := testAccServer(t)
+
+	target, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var proxied atomic.Int64
+	forward := httputil.NewSingleHostReverseProxy(target)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Add(1)
+		forward.ServeHTTP(w, r)
+	}))
+	t.Cleanup(proxy.Close)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(srv, fmt.Sprintf("proxy_url = %q", proxy.URL)) + `data "appstore_apps" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.appstore_apps.test", "apps.#", "1"),
+					func(_ *terraform.State) error {
+						if proxied.Load() == 0 {
+							return fmt.Errorf("no requests were sent through the proxy")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_invalidSettings(t *testing.T) {
+	srv, _ := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(srv, `request_timeout = "-1s"`) + `data "appstore_apps" "test" {}`,
+				ExpectError: regexp.MustCompile(`Invalid Request Timeout`),
+			},
+			{
+				Config:      testAccProviderConfig(srv, `ca_cert_pem = "not a certificate"`) + `data "appstore_apps" "test" {}`,
+				ExpectError: regexp.MustCompile(`Invalid CA Certificate`),
+			},
+			{
+				Config:      testAccProviderConfig(srv, `token_lifetime = "1h"`) + `data "appstore_apps" "test" {}`,
+				ExpectError: regexp.MustCompile(`Invalid Token Lifetime`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_tokenScope(t *testing.T) {
+	srv, gameCenterID := testAccServer(t)
+
+	config := testAccProviderConfig(srv, `token_lifetime = "5m"`, `scope = ["GET /v1/apps"]`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `data "appstore_apps" "test" {}`,
+				Check:  resource.TestCheckResourceAttr("data.appstore_apps.test", "apps.#", "1"),
+			},
+			{
+				Config:      config + testAccAchievementConfig(gameCenterID, "First Win", 10),
+				ExpectError: regexp.MustCompile(`NOT_AUTHORIZED`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_invalidPrivateKey(t *testing.T) {
+	srv, _ := testAccServer(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p384Key := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	config := func(attribute string) string {
+		return testAccProviderConfig(srv, attribute) + `data "appstore_apps" "test" {}`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`private_key = "not a key"`),
+				ExpectError: regexp.MustCompile(`Invalid Private Key(.|\n)*not PEM-encoded`),
+			},
+			{
+				Config:      config(testAccPrivateKeyAttribute(p384Key)),
+				ExpectError: regexp.MustCompile(`Invalid Private Key(.|\n)*P-384`),
+			},
+			{
+				Config:      config(`key_id = "key"`),
+				ExpectError: regexp.MustCompile(`Invalid Key ID`),
+			},
+			{
+				Config:      config(`issuer_id = "issuer"`),
+				ExpectError: regexp.MustCompile(`Invalid Issuer ID`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_validateCredentials(t *testing.T) {
+	srv, _ := testAccServer(t)
+
+	otherKey, err := fakeasc.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The verification fails before the data source is read.
+	config := testAccProviderConfig(srv, "validate_credentials = true") + `data "appstore_apps" "test" {}`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config:      testAccProviderConfig(srv, "validate_credentials = true", testAccPrivateKeyAttribute(otherKey)) + `data "appstore_apps" "test" {}`,
+				ExpectError: regexp.MustCompile(`Invalid Credentials`),
+			},
+			{
+				PreConfig: func() {
+					srv.InjectFault(fakeasc.Fault{Method: http.MethodGet, Path: "/v1/apps", Status: http.StatusForbidden})
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Insufficient Key Permissions`),
+			},
+			{
+				PreConfig: srv.ClearFaults,
+				Config:    config,
+			},
+		},
+	})
+}
+
+func TestAccProvider_privateKeyPath(t *testing.T) {
+	srv, _ := testAccServer(t)
+
+	keyFile := testAccImageFile(t, "AuthKey.p8", testAccPrivateKey)
+	config := func(keyAttributes string) string {
+		return fmt.Sprintf(`
+provider "appstore" {
+  endpoint    = %q
+  key_id      = %q
+  issuer_id   = %q
+  max_retries = 0
+%s
+}
+
+data "appstore_apps" "test" {}
+`, srv.URL, testAccKeyID, testAccIssuerID, keyAttributes)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(fmt.Sprintf(`private_key_path = %q`, filepath.Join(t.TempDir(), "missing.p8"))),
+				ExpectError: regexp.MustCompile(`Unreadable Private Key File`),
+			},
+			{
+				Config: config(fmt.Sprintf(`private_key      = "not a key"
+  private_key_path = %q`, keyFile)),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: config(fmt.Sprintf(`private_key_path = %q`, keyFile)),
+				Check:  resource.TestCheckResourceAttr("data.appstore_apps.test", "apps.#", "1"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_environment(t *testing.T) {
+	srv, _ := testAccServer(t)
+
+	keyFile := testAccImageFile(t, "AuthKey.p8", testAccPrivateKey)
+	config := `
+provider "appstore" {
+  max_retries = 0
+}
+
+data "appstore_apps" "test" {}
+`
+
+	t.Setenv("APPSTORE_ENDPOINT", srv.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The deprecated names are ignored when the current ones are set.
+				PreConfig: func() {
+					t.Setenv("APPSTORE_KEY_ID", testAccKeyID)
+					t.Setenv("APPSTORE_ISSUER_ID", testAccIssuerID)
+					t.Setenv("APPSTORE_PRIVATE_KEY", string(testAccPrivateKey))
+					t.Setenv("KEY_ID", "key")
+					t.Setenv("ISSUER_ID", "issuer")
+					t.Setenv("PRIVATE_KEY", "not a key")
+				},
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("data.appstore_apps.test", "apps.#", "1"),
+			},
+			{
+				// The deprecated names are still read, with a warning.
+				PreConfig: func() {
+					t.Setenv("APPSTORE_KEY_ID", "")
+					t.Setenv("APPSTORE_ISSUER_ID", "")
+					t.Setenv("APPSTORE_PRIVATE_KEY", "")
+					t.Setenv("KEY_ID", testAccKeyID)
+					t.Setenv("ISSUER_ID", testAccIssuerID)
+					t.Setenv("PRIVATE_KEY", string(testAccPrivateKey))
+				},
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("data.appstore_apps.test", "apps.#", "1"),
+			},
+			{
+				// The inline key takes precedence over the key file within the environment.
+				PreConfig: func() {
+					t.Setenv("PRIVATE_KEY", "")
+					t.Setenv("APPSTORE_PRIVATE_KEY", "not a key")
+					t.Setenv("APPSTORE_PRIVATE_KEY_PATH", keyFile)
+				},
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid Private Key`),
+			},
+			{
+				PreConfig: func() {
+					t.Setenv("APPSTORE_PRIVATE_KEY", "")
+				},
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("data.appstore_apps.test", "apps.#", "1"),
+			},
+		},
+	})
+}
+
+func TestGetenvDeprecated(t *testing.T) {
+	t.Setenv("APPSTORE_KEY_ID", "")
+	t.Setenv("KEY_ID", testAccKeyID)
+
+	var diags diag.Diagnostics
+	if value := getenv("APPSTORE_KEY_ID", "KEY_ID", &diags); value != testAccKeyID {
+		t.Errorf("got %q, want %q", value, testAccKeyID)
+	}
+	if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Deprecated Environment Variable" {
+		t.Errorf("got diagnostics %v, want the deprecation warning", diags)
+	}
+
+	t.Setenv("APPSTORE_KEY_ID", testAccKeyID)
+	t.Setenv("KEY_ID", "key")
+
+	diags = nil
+	if value := getenv("APPSTORE_KEY_ID", "KEY_ID", &diags); value != testAccKeyID {
+		t.Errorf("got %q, want %q", value, testAccKeyID)
+	}
+	if len(diags) != 0 {
+		t.Errorf("got diagnostics %v, want none", diags)
+	}
+}
+
+func TestAccProvider_unknownSettings(t *testing.T) {
+	srv, _ := testAccServer(t)
+
+	settings := []struct {
+		attribute string
+		input     string
+		summary   string
+	}{
+		{attribute: "max_retries", input: `1`, summary: "Unknown Max Retries"},
+		{attribute: "retry_wait_min", input: `"1s"`, summary: "Unknown Retry Wait Min"},
+		{attribute: "retry_wait_max", input: `"10s"`, summary: "Unknown Retry Wait Max"},
+		{attribute: "max_concurrent_requests", input: `2`, summary: "Unknown Max Concurrent Requests"},
+		{attribute: "request_timeout", input: `"30s"`, summary: "Unknown Request Timeout"},
+		{attribute: "proxy_url", input: `"http://localhost:3128"`, summary: "Unknown Proxy URL"},
+		{attribute: "ca_cert_pem", input: `"not a certificate"`, summary: "Unknown CA Certificate"},
+		{attribute: "ca_cert_path", input: `"ca.pem"`, summary: "Unknown CA Certificate Path"},
+		{attribute: "token_lifetime", input: `"5m"`, summary: "Unknown Token Lifetime"},
+		{attribute: "scope", input: `["GET /v1/apps"]`, summary: "Unknown Scope"},
+		{attribute: "validate_credentials", input: `true`, summary: "Unknown Validate Credentials"},
+	}
+
+	// The output of terraform_data is unknown until it is created, so the provider is configured with an unknown value.
+	steps := []resource.TestStep{}
+	for _, setting := range settings {
+		steps = append(steps, resource.TestStep{
+			Config: testAccProviderConfig(srv, setting.attribute+" = terraform_data.test.output") + fmt.Sprintf(`
+resource "terraform_data" "test" {
+  input = %s
+}
+
+data "appstore_apps" "test" {}
+`, setting.input),
+			ExpectError: regexp.MustCompile(setting.summary),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccProviderConfig(srv) + `data "appstore_apps" "test" {}`,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
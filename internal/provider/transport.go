@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/alexprogrammr/terraform-provider-appstore/internal/connect"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const defaultRequestTimeout = 5 * time.Minute
+
+// httpTransport returns the transport configured with the timeout, proxy and certificate authorities of the provider.
+func httpTransport(config appstoreProviderModel, diags *diag.Diagnostics) http.RoundTripper {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	timeout := defaultRequestTimeout
+	if !config.RequestTimeout.IsNull() {
+		value, err := time.ParseDuration(config.RequestTimeout.ValueString())
+		if err != nil || value <= 0 {
+			diags.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				"The request timeout must be a positive duration, for example, 30s or 10m.",
+			)
+		}
+		timeout = value
+	}
+
+	if !config.ProxyURL.IsNull() {
+		proxy, err := url.Parse(config.ProxyURL.ValueString())
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			diags.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Proxy URL",
+				"The proxy URL must be an absolute URL, for example, http://proxy.example.com:3128.",
+			)
+		} else {
+			transport.Proxy = http.ProxyURL(proxy)
+		}
+	}
+
+	caCert, caCertAttr := []byte(nil), path.Root("ca_cert_pem")
+	switch {
+	case !config.CACertPEM.IsNull():
+		caCert = []byte(config.CACertPEM.ValueString())
+	case !config.CACertPath.IsNull():
+		caCertAttr = path.Root("ca_cert_path")
+
+		data, err := os.ReadFile(config.CACertPath.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				caCertAttr,
+				"Unreadable CA Certificate File",
+				"The provider cannot read the CA certificate file: "+err.Error(),
+			)
+			return nil
+		}
+		caCert = data
+	}
+
+	if caCert != nil {
+		// Custom certificate authorities are trusted in addition to the system ones,
+		// as only some of the hosts may be behind a TLS-intercepting proxy.
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(caCert) {
+			diags.AddAttributeError(
+				caCertAttr,
+				"Invalid CA Certificate",
+				"The CA certificate bundle does not contain any PEM-encoded certificate.",
+			)
+			return nil
+		}
+
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+
+	return connect.NewTimeoutTransport(transport, timeout)
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func stringValues(values []types.String) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.ValueString())
+	}
+
+	return result
+}
+
+func stringsValue(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, v := range values {
+		result = append(result, types.StringValue(v))
+	}
+
+	return result
+}